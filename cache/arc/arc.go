@@ -0,0 +1,217 @@
+package arc
+
+import (
+	"container/list"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache"
+)
+
+// entry is a key tracked by one of the four ARC lists. Entries in the
+// ghost lists keep only their key.
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+	owner *list.List
+}
+
+// ARC is an adaptive replacement cache. It balances a recency list (T1)
+// and a frequency list (T2), using the ghost lists B1 and B2 of recently
+// evicted keys to learn which of the two deserves more space.
+type ARC[K comparable, V any] struct {
+	capacity int
+	target   int // the adaptive target size of T1
+	t1, t2   *list.List
+	b1, b2   *list.List
+	items    map[K]*list.Element
+	onEvict  cache.EvictionFunc[K, V]
+	stats    cache.Stats
+}
+
+// New creates an ARC cache holding at most capacity entries.
+// onEvict may be nil. It panics if capacity is not positive.
+func New[K comparable, V any](capacity int, onEvict cache.EvictionFunc[K, V]) *ARC[K, V] {
+	if capacity <= 0 {
+		panic("arc: capacity must be positive")
+	}
+	return &ARC[K, V]{
+		capacity: capacity,
+		t1:       list.New(),
+		t2:       list.New(),
+		b1:       list.New(),
+		b2:       list.New(),
+		items:    make(map[K]*list.Element),
+		onEvict:  onEvict,
+	}
+}
+
+// NewSync creates a thread-safe ARC cache.
+func NewSync[K comparable, V any](capacity int, onEvict cache.EvictionFunc[K, V]) *cache.SyncCache[K, V] {
+	return cache.NewSync[K, V](New(capacity, onEvict))
+}
+
+// Get returns the value stored for key. A hit promotes the key to T2.
+func (c *ARC[K, V]) Get(key K) (V, bool) {
+	elem, ok := c.cached(key)
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.moveTo(elem, c.t2)
+	return elem.Value.(*entry[K, V]).value, true
+}
+
+// Peek returns the value stored for key without side effects.
+func (c *ARC[K, V]) Peek(key K) (V, bool) {
+	if elem, ok := c.cached(key); ok {
+		return elem.Value.(*entry[K, V]).value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Put stores value for key, adapting the T1 target on ghost hits.
+func (c *ARC[K, V]) Put(key K, value V) {
+	elem, exists := c.items[key]
+	if exists {
+		e := elem.Value.(*entry[K, V])
+		switch e.owner {
+		case c.t1, c.t2:
+			e.value = value
+			c.moveTo(elem, c.t2)
+			return
+		case c.b1:
+			c.target = min(c.capacity, c.target+max(c.b2.Len()/c.b1.Len(), 1))
+			c.replace(false)
+		case c.b2:
+			c.target = max(0, c.target-max(c.b1.Len()/c.b2.Len(), 1))
+			c.replace(true)
+		}
+		e.value = value
+		c.moveTo(elem, c.t2)
+		return
+	}
+
+	if c.t1.Len()+c.b1.Len() >= c.capacity {
+		if c.t1.Len() < c.capacity {
+			c.dropGhost(c.b1)
+			c.replace(false)
+		} else {
+			c.evict(c.t1.Back(), nil)
+		}
+	} else if total := c.t1.Len() + c.t2.Len() + c.b1.Len() + c.b2.Len(); total >= c.capacity {
+		if total >= 2*c.capacity {
+			c.dropGhost(c.b2)
+		}
+		c.replace(false)
+	}
+	e := &entry[K, V]{key: key, value: value, owner: c.t1}
+	c.items[key] = c.t1.PushFront(e)
+}
+
+// Remove deletes key, including any ghost history, and reports whether
+// it was cached.
+func (c *ARC[K, V]) Remove(key K) bool {
+	elem, exists := c.items[key]
+	if !exists {
+		return false
+	}
+	e := elem.Value.(*entry[K, V])
+	e.owner.Remove(elem)
+	delete(c.items, key)
+	return e.owner == c.t1 || e.owner == c.t2
+}
+
+// Contains checks if key is cached. Ghost entries do not count.
+func (c *ARC[K, V]) Contains(key K) bool {
+	_, ok := c.cached(key)
+	return ok
+}
+
+// Size returns the number of cached entries.
+func (c *ARC[K, V]) Size() int {
+	return c.t1.Len() + c.t2.Len()
+}
+
+// Capacity returns the maximum number of cached entries.
+func (c *ARC[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Clear removes all entries and ghost history and resets the target.
+func (c *ARC[K, V]) Clear() {
+	c.t1.Init()
+	c.t2.Init()
+	c.b1.Init()
+	c.b2.Init()
+	c.items = make(map[K]*list.Element)
+	c.target = 0
+}
+
+// Stats returns a snapshot of the cache statistics.
+func (c *ARC[K, V]) Stats() cache.Stats {
+	return c.stats
+}
+
+// cached returns the element for key if it holds a value.
+func (c *ARC[K, V]) cached(key K) (*list.Element, bool) {
+	elem, exists := c.items[key]
+	if !exists {
+		return nil, false
+	}
+	owner := elem.Value.(*entry[K, V]).owner
+	return elem, owner == c.t1 || owner == c.t2
+}
+
+// replace makes room in the cache by demoting the LRU entry of T1 or T2
+// into its ghost list. inB2 tells whether the key being admitted was a
+// B2 ghost hit.
+func (c *ARC[K, V]) replace(inB2 bool) {
+	if c.t1.Len()+c.t2.Len() < c.capacity {
+		return
+	}
+	if c.t1.Len() > 0 && (c.t1.Len() > c.target || (inB2 && c.t1.Len() == c.target)) {
+		c.evict(c.t1.Back(), c.b1)
+	} else {
+		c.evict(c.t2.Back(), c.b2)
+	}
+}
+
+// evict drops the value of elem and moves its key to ghost, or forgets
+// it entirely if ghost is nil.
+func (c *ARC[K, V]) evict(elem *list.Element, ghost *list.List) {
+	e := elem.Value.(*entry[K, V])
+	c.stats.Evictions++
+	if c.onEvict != nil {
+		c.onEvict(e.key, e.value)
+	}
+	var zero V
+	e.value = zero
+	if ghost == nil {
+		e.owner.Remove(elem)
+		delete(c.items, e.key)
+		return
+	}
+	c.moveTo(elem, ghost)
+}
+
+// dropGhost forgets the oldest key of a ghost list.
+func (c *ARC[K, V]) dropGhost(ghost *list.List) {
+	if elem := ghost.Back(); elem != nil {
+		ghost.Remove(elem)
+		delete(c.items, elem.Value.(*entry[K, V]).key)
+	}
+}
+
+// moveTo places elem at the MRU end of target.
+func (c *ARC[K, V]) moveTo(elem *list.Element, target *list.List) {
+	e := elem.Value.(*entry[K, V])
+	if e.owner == target {
+		target.MoveToFront(elem)
+		return
+	}
+	e.owner.Remove(elem)
+	e.owner = target
+	c.items[e.key] = target.PushFront(e)
+}
@@ -0,0 +1,67 @@
+package arc_test
+
+import (
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache/arc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestARC_ScanResistance(t *testing.T) {
+	c := arc.New[int, int](4, nil)
+
+	// Keys used twice move to the frequency list
+	for _, key := range []int{1, 2, 1, 2} {
+		if _, ok := c.Get(key); !ok {
+			c.Put(key, key)
+		}
+	}
+
+	// A long one-off scan only churns the recency list
+	for key := 100; key < 120; key++ {
+		c.Put(key, key)
+	}
+
+	assert.True(t, c.Contains(1))
+	assert.True(t, c.Contains(2))
+	assert.Equal(t, 4, c.Size())
+}
+
+func TestARC_GhostHitAdapts(t *testing.T) {
+	c := arc.New[int, int](2, nil)
+	c.Put(1, 1)
+	c.Put(2, 2)
+	c.Put(3, 3)
+
+	// 1 was demoted to a ghost; bringing it back lands it in T2
+	assert.False(t, c.Contains(1))
+	c.Put(1, 10)
+	value, ok := c.Get(1)
+	assert.True(t, ok)
+	assert.Equal(t, 10, value)
+	assert.Equal(t, 2, c.Size())
+}
+
+func TestARC_BasicOperations(t *testing.T) {
+	var c cache.Cache[string, int] = arc.New[string, int](2, nil)
+
+	c.Put("a", 1)
+	_, ok := c.Get("b")
+	assert.False(t, ok)
+	value, ok := c.Peek("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+	assert.Equal(t, cache.Stats{Misses: 1}, c.Stats())
+
+	assert.True(t, c.Remove("a"))
+	assert.False(t, c.Remove("a"))
+	assert.Equal(t, 2, c.Capacity())
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	assert.Equal(t, 2, c.Size())
+	c.Clear()
+	assert.Equal(t, 0, c.Size())
+}
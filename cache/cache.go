@@ -0,0 +1,53 @@
+package cache
+
+// Cache defines the operations shared by the bounded caches in this package.
+// Implementations differ only in which entry they evict when full.
+type Cache[K comparable, V any] interface {
+	// Get returns the value stored for key and records a hit or a miss.
+	Get(key K) (V, bool)
+
+	// Peek returns the value stored for key without updating the
+	// eviction order or the statistics.
+	Peek(key K) (V, bool)
+
+	// Put stores value for key, evicting an entry if the cache is full.
+	Put(key K, value V)
+
+	// Remove deletes key and reports whether it was present.
+	// Removal does not invoke the eviction callback.
+	Remove(key K) bool
+
+	// Contains checks if key is cached without updating the eviction order.
+	Contains(key K) bool
+
+	// Size returns the number of cached entries.
+	Size() int
+
+	// Capacity returns the maximum number of cached entries.
+	Capacity() int
+
+	// Clear removes all entries and history but keeps the statistics.
+	Clear()
+
+	// Stats returns a snapshot of the hit, miss and eviction counters.
+	Stats() Stats
+}
+
+// EvictionFunc is called with every entry a cache evicts to make room.
+type EvictionFunc[K comparable, V any] func(key K, value V)
+
+// Stats holds the counters collected by a cache.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+// HitRatio returns the fraction of lookups that were hits.
+func (s Stats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
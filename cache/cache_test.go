@@ -0,0 +1,129 @@
+package cache_test
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache/arc"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache/lfu"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache/lru"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache/tinylfu"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache/twoq"
+	"github.com/stretchr/testify/assert"
+)
+
+// policies lists every cache implementation under comparison.
+var policies = []struct {
+	name string
+	new  func(capacity int) cache.Cache[string, int]
+}{
+	{"LRU", func(capacity int) cache.Cache[string, int] { return lru.New[string, int](capacity, nil) }},
+	{"LFU", func(capacity int) cache.Cache[string, int] { return lfu.New[string, int](capacity, nil) }},
+	{"ARC", func(capacity int) cache.Cache[string, int] { return arc.New[string, int](capacity, nil) }},
+	{"2Q", func(capacity int) cache.Cache[string, int] { return twoq.New[string, int](capacity, nil) }},
+	{"TinyLFU", func(capacity int) cache.Cache[string, int] {
+		return tinylfu.New(tinylfu.Config[string, int]{MaxCost: int64(capacity), NumCounters: 1024})
+	}},
+}
+
+// loadTrace reads a recorded key trace, one key per line.
+func loadTrace(tb testing.TB, path string) []string {
+	file, err := os.Open(path)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	defer file.Close()
+
+	var keys []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			keys = append(keys, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		tb.Fatal(err)
+	}
+	return keys
+}
+
+// replay runs the trace through c, loading every miss.
+func replay(c cache.Cache[string, int], keys []string) cache.Stats {
+	for i, key := range keys {
+		if _, ok := c.Get(key); !ok {
+			c.Put(key, i)
+		}
+	}
+	return c.Stats()
+}
+
+func TestPolicies_ReplayTrace(t *testing.T) {
+	keys := loadTrace(t, "testdata/zipf_scan.trace")
+	ratios := make(map[string]float64)
+
+	for _, policy := range policies {
+		c := policy.new(100)
+		stats := replay(c, keys)
+		ratios[policy.name] = stats.HitRatio()
+		t.Logf("%-7s hit ratio %.3f (%d evictions)", policy.name, stats.HitRatio(), stats.Evictions)
+
+		assert.Equal(t, uint64(len(keys)), stats.Hits+stats.Misses, policy.name)
+		assert.LessOrEqual(t, c.Size(), c.Capacity(), policy.name)
+		assert.Equal(t, stats.Misses-uint64(c.Size()), stats.Evictions, policy.name)
+	}
+
+	// The trace mixes a skewed workload with scans, which the
+	// scan-resistant policies should survive better than plain LRU.
+	assert.Greater(t, ratios["ARC"], ratios["LRU"])
+	assert.Greater(t, ratios["2Q"], ratios["LRU"])
+	assert.Greater(t, ratios["TinyLFU"], ratios["LRU"])
+}
+
+func TestPolicies_EvictionCallback(t *testing.T) {
+	for _, policy := range policies {
+		evicted := 0
+		var c cache.Cache[int, int]
+		onEvict := func(int, int) { evicted++ }
+		switch policy.name {
+		case "LRU":
+			c = lru.New[int, int](10, onEvict)
+		case "LFU":
+			c = lfu.New[int, int](10, onEvict)
+		case "ARC":
+			c = arc.New[int, int](10, onEvict)
+		case "2Q":
+			c = twoq.New[int, int](10, onEvict)
+		case "TinyLFU":
+			c = tinylfu.New(tinylfu.Config[int, int]{MaxCost: 10, OnEvict: onEvict})
+		}
+
+		for i := 0; i < 100; i++ {
+			c.Put(i, i)
+		}
+		assert.Equal(t, 10, c.Size(), policy.name)
+		assert.Equal(t, 90, evicted, policy.name)
+		assert.Equal(t, uint64(90), c.Stats().Evictions, policy.name)
+	}
+}
+
+func TestStats_HitRatio(t *testing.T) {
+	assert.Equal(t, 0.0, cache.Stats{}.HitRatio())
+	assert.Equal(t, 0.75, cache.Stats{Hits: 3, Misses: 1}.HitRatio())
+}
+
+func BenchmarkPolicies_ReplayTrace(b *testing.B) {
+	keys := loadTrace(b, "testdata/zipf_scan.trace")
+	for _, policy := range policies {
+		b.Run(policy.name, func(b *testing.B) {
+			var stats cache.Stats
+			for i := 0; i < b.N; i++ {
+				stats = replay(policy.new(100), keys)
+			}
+			b.ReportMetric(stats.HitRatio(), "hit-ratio")
+		})
+	}
+}
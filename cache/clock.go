@@ -0,0 +1,19 @@
+package cache
+
+import "time"
+
+// Clock tells the time. Caches that expire entries take a Clock so that
+// tests can move time forward without sleeping.
+type Clock interface {
+	Now() time.Time
+}
+
+// systemClock is the Clock backed by time.Now.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// SystemClock is the default Clock, backed by time.Now.
+var SystemClock Clock = systemClock{}
@@ -0,0 +1,173 @@
+package lfu
+
+import (
+	"container/list"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache"
+)
+
+// bucket groups the entries that have been used freq times, ordered from
+// least to most recently used.
+type bucket[K comparable, V any] struct {
+	freq    int
+	entries *list.List
+}
+
+// entry is a cached key-value pair together with its frequency bucket.
+type entry[K comparable, V any] struct {
+	key    K
+	value  V
+	bucket *list.Element
+}
+
+// LFU is a cache that evicts the least frequently used entry, breaking
+// ties by recency. Every operation runs in O(1): entries live in a list of
+// frequency buckets and moving an entry only touches the adjacent bucket.
+type LFU[K comparable, V any] struct {
+	capacity int
+	items    map[K]*list.Element
+	buckets  *list.List
+	onEvict  cache.EvictionFunc[K, V]
+	stats    cache.Stats
+}
+
+// New creates an LFU cache holding at most capacity entries.
+// onEvict may be nil. It panics if capacity is not positive.
+func New[K comparable, V any](capacity int, onEvict cache.EvictionFunc[K, V]) *LFU[K, V] {
+	if capacity <= 0 {
+		panic("lfu: capacity must be positive")
+	}
+	return &LFU[K, V]{
+		capacity: capacity,
+		items:    make(map[K]*list.Element),
+		buckets:  list.New(),
+		onEvict:  onEvict,
+	}
+}
+
+// NewSync creates a thread-safe LFU cache.
+func NewSync[K comparable, V any](capacity int, onEvict cache.EvictionFunc[K, V]) *cache.SyncCache[K, V] {
+	return cache.NewSync[K, V](New(capacity, onEvict))
+}
+
+// Get returns the value stored for key and increments its frequency.
+func (c *LFU[K, V]) Get(key K) (V, bool) {
+	elem, exists := c.items[key]
+	if !exists {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.touch(elem)
+	return elem.Value.(*entry[K, V]).value, true
+}
+
+// Peek returns the value stored for key without changing its frequency.
+func (c *LFU[K, V]) Peek(key K) (V, bool) {
+	if elem, exists := c.items[key]; exists {
+		return elem.Value.(*entry[K, V]).value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Put stores value for key. Updating an existing key counts as a use.
+func (c *LFU[K, V]) Put(key K, value V) {
+	if elem, exists := c.items[key]; exists {
+		elem.Value.(*entry[K, V]).value = value
+		c.touch(elem)
+		return
+	}
+	if len(c.items) >= c.capacity {
+		c.evict()
+	}
+	first := c.buckets.Front()
+	if first == nil || first.Value.(*bucket[K, V]).freq != 1 {
+		first = c.buckets.PushFront(&bucket[K, V]{freq: 1, entries: list.New()})
+	}
+	e := &entry[K, V]{key: key, value: value, bucket: first}
+	c.items[key] = first.Value.(*bucket[K, V]).entries.PushBack(e)
+}
+
+// Remove deletes key and reports whether it was present.
+func (c *LFU[K, V]) Remove(key K) bool {
+	elem, exists := c.items[key]
+	if exists {
+		c.unlink(elem)
+		delete(c.items, key)
+	}
+	return exists
+}
+
+// Contains checks if key is cached.
+func (c *LFU[K, V]) Contains(key K) bool {
+	_, exists := c.items[key]
+	return exists
+}
+
+// Frequency returns how many times key has been used, or 0 if absent.
+func (c *LFU[K, V]) Frequency(key K) int {
+	elem, exists := c.items[key]
+	if !exists {
+		return 0
+	}
+	return elem.Value.(*entry[K, V]).bucket.Value.(*bucket[K, V]).freq
+}
+
+// Size returns the number of cached entries.
+func (c *LFU[K, V]) Size() int {
+	return len(c.items)
+}
+
+// Capacity returns the maximum number of cached entries.
+func (c *LFU[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Clear removes all entries.
+func (c *LFU[K, V]) Clear() {
+	c.items = make(map[K]*list.Element)
+	c.buckets.Init()
+}
+
+// Stats returns a snapshot of the cache statistics.
+func (c *LFU[K, V]) Stats() cache.Stats {
+	return c.stats
+}
+
+// touch moves the entry into the bucket for the next frequency.
+func (c *LFU[K, V]) touch(elem *list.Element) {
+	e := elem.Value.(*entry[K, V])
+	current := e.bucket
+	freq := current.Value.(*bucket[K, V]).freq + 1
+	next := current.Next()
+	if next == nil || next.Value.(*bucket[K, V]).freq != freq {
+		next = c.buckets.InsertAfter(&bucket[K, V]{freq: freq, entries: list.New()}, current)
+	}
+	c.unlink(elem)
+	e.bucket = next
+	c.items[e.key] = next.Value.(*bucket[K, V]).entries.PushBack(e)
+}
+
+// unlink removes the entry from its bucket, dropping the bucket if empty.
+func (c *LFU[K, V]) unlink(elem *list.Element) {
+	e := elem.Value.(*entry[K, V])
+	b := e.bucket.Value.(*bucket[K, V])
+	b.entries.Remove(elem)
+	if b.entries.Len() == 0 {
+		c.buckets.Remove(e.bucket)
+	}
+}
+
+// evict drops the least recently used entry of the lowest frequency.
+func (c *LFU[K, V]) evict() {
+	elem := c.buckets.Front().Value.(*bucket[K, V]).entries.Front()
+	e := elem.Value.(*entry[K, V])
+	c.unlink(elem)
+	delete(c.items, e.key)
+	c.stats.Evictions++
+	if c.onEvict != nil {
+		c.onEvict(e.key, e.value)
+	}
+}
@@ -0,0 +1,69 @@
+package lfu_test
+
+import (
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache/lfu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLFU_EvictsLeastFrequentlyUsed(t *testing.T) {
+	var evicted []string
+	c := lfu.New[string, int](2, func(key string, _ int) {
+		evicted = append(evicted, key)
+	})
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	_, _ = c.Get("a")
+	_, _ = c.Get("a")
+	_, _ = c.Get("b")
+	c.Put("c", 3)
+
+	assert.Equal(t, []string{"b"}, evicted)
+	assert.Equal(t, 3, c.Frequency("a"))
+	assert.Equal(t, 1, c.Frequency("c"))
+	assert.Equal(t, 0, c.Frequency("b"))
+}
+
+func TestLFU_TieBreaksByRecency(t *testing.T) {
+	c := lfu.New[int, int](3, nil)
+	c.Put(1, 1)
+	c.Put(2, 2)
+	c.Put(3, 3)
+	_, _ = c.Get(1)
+	_, _ = c.Get(2)
+	_, _ = c.Get(3)
+	c.Put(4, 4)
+
+	assert.False(t, c.Contains(1))
+	assert.True(t, c.Contains(2))
+	assert.True(t, c.Contains(4))
+}
+
+func TestLFU_BasicOperations(t *testing.T) {
+	var c cache.Cache[string, int] = lfu.New[string, int](2, nil)
+
+	c.Put("a", 1)
+	c.Put("a", 2)
+	value, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 2, value)
+	_, ok = c.Get("b")
+	assert.False(t, ok)
+
+	value, _ = c.Peek("a")
+	assert.Equal(t, 2, value)
+	assert.Equal(t, cache.Stats{Hits: 1, Misses: 1}, c.Stats())
+
+	assert.True(t, c.Remove("a"))
+	assert.False(t, c.Contains("a"))
+	assert.Equal(t, 2, c.Capacity())
+
+	c.Put("b", 1)
+	c.Clear()
+	assert.Equal(t, 0, c.Size())
+	c.Put("c", 1)
+	assert.Equal(t, 1, c.Size())
+}
@@ -0,0 +1,308 @@
+package loading
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache/lru"
+)
+
+// ErrNoLoader is returned by New when the configuration has no Loader.
+var ErrNoLoader = errors.New("loading: a Loader is required")
+
+// ErrLoaderPanicked is wrapped by the error returned for a load whose
+// Loader panicked.
+var ErrLoaderPanicked = errors.New("loading: loader panicked")
+
+// Loader fetches the value for a key from the backend.
+type Loader[K comparable, V any] func(ctx context.Context, key K) (V, error)
+
+// Config describes a loading cache.
+type Config[K comparable, V any] struct {
+	// Loader fetches missing values. It is required.
+	Loader Loader[K, V]
+
+	// Capacity bounds the number of cached keys using LRU eviction.
+	// Zero means unbounded.
+	Capacity int
+
+	// ExpireAfterWrite drops a value once it is this old, so the next
+	// Get loads it again. Zero means values never expire.
+	ExpireAfterWrite time.Duration
+
+	// RefreshAfterWrite makes a Get that finds a value at least this old
+	// return it immediately while reloading it in the background.
+	// Zero disables refreshing.
+	RefreshAfterWrite time.Duration
+
+	// NegativeTTL caches loader errors for this long, so that a failing
+	// key does not hit the backend on every Get. Zero disables it.
+	NegativeTTL time.Duration
+
+	// Clock is used for expiry and refresh. Defaults to cache.SystemClock.
+	Clock cache.Clock
+}
+
+// entry is a loaded value, or a cached loader error.
+type entry[V any] struct {
+	value    V
+	err      error
+	loadedAt time.Time
+}
+
+// call is a load in flight, shared by every goroutine asking for the key.
+type call[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+// store is the part of a cache the loading cache keeps entries in.
+type store[K comparable, V any] interface {
+	Peek(key K) (V, bool)
+	Get(key K) (V, bool)
+	Put(key K, value V)
+	Remove(key K) bool
+	Size() int
+	Clear()
+}
+
+// Cache is a thread-safe cache that loads missing values through a Loader.
+// Concurrent misses on the same key share a single load. Loads run in
+// their own goroutine, detached from the cancellation of the caller that
+// started them, so that one caller giving up does not fail the others.
+type Cache[K comparable, V any] struct {
+	config Config[K, V]
+	store  store[K, *entry[V]]
+	calls  map[K]*call[V]
+	stats  cache.Stats
+	mu     sync.Mutex
+}
+
+// New creates a loading cache.
+func New[K comparable, V any](config Config[K, V]) (*Cache[K, V], error) {
+	if config.Loader == nil {
+		return nil, ErrNoLoader
+	}
+	if config.Clock == nil {
+		config.Clock = cache.SystemClock
+	}
+	c := &Cache[K, V]{
+		config: config,
+		calls:  make(map[K]*call[V]),
+	}
+	if config.Capacity > 0 {
+		c.store = lru.New[K, *entry[V]](config.Capacity, func(K, *entry[V]) {
+			c.stats.Evictions++
+		})
+	} else {
+		c.store = newMapStore[K, *entry[V]]()
+	}
+	return c, nil
+}
+
+// Get returns the value for key, loading it if it is missing or expired.
+// If a load for key is already running, Get waits for it instead of
+// starting another one. Get returns ctx.Err() if ctx is done first.
+func (c *Cache[K, V]) Get(ctx context.Context, key K) (V, error) {
+	c.mu.Lock()
+	if e, ok := c.fresh(key); ok {
+		c.stats.Hits++
+		if e.err == nil && c.needsRefresh(e) {
+			c.load(ctx, key)
+		}
+		c.mu.Unlock()
+		return e.value, e.err
+	}
+	c.stats.Misses++
+	inflight := c.load(ctx, key)
+	c.mu.Unlock()
+
+	select {
+	case <-inflight.done:
+		return inflight.value, inflight.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// GetIfPresent returns the cached value for key without loading it.
+// Cached errors are reported as absent.
+func (c *Cache[K, V]) GetIfPresent(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.fresh(key); ok && e.err == nil {
+		return e.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Put stores value for key, superseding any load in flight.
+func (c *Cache[K, V]) Put(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.calls, key)
+	c.store.Put(key, &entry[V]{value: value, loadedAt: c.config.Clock.Now()})
+}
+
+// Refresh reloads key in the background. The current value, if any,
+// keeps being served until the load completes; a failed refresh keeps it.
+func (c *Cache[K, V]) Refresh(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.load(context.Background(), key)
+}
+
+// Invalidate removes key. A load in flight still answers its waiters but
+// its result is not cached.
+func (c *Cache[K, V]) Invalidate(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.calls, key)
+	c.store.Remove(key)
+}
+
+// InvalidateAll removes every key.
+func (c *Cache[K, V]) InvalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.calls)
+	c.store.Clear()
+}
+
+// Size returns the number of cached entries, including cached errors.
+func (c *Cache[K, V]) Size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.store.Size()
+}
+
+// Stats returns a snapshot of the cache statistics.
+func (c *Cache[K, V]) Stats() cache.Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// fresh returns the entry for key if it has not expired.
+// It must be called with the lock held.
+func (c *Cache[K, V]) fresh(key K) (*entry[V], bool) {
+	e, ok := c.store.Get(key)
+	if !ok {
+		return nil, false
+	}
+	ttl := c.config.ExpireAfterWrite
+	if e.err != nil {
+		ttl = c.config.NegativeTTL
+	}
+	if ttl > 0 && !c.config.Clock.Now().Before(e.loadedAt.Add(ttl)) {
+		c.store.Remove(key)
+		return nil, false
+	}
+	return e, true
+}
+
+// needsRefresh reports whether e is old enough to be reloaded.
+func (c *Cache[K, V]) needsRefresh(e *entry[V]) bool {
+	refresh := c.config.RefreshAfterWrite
+	return refresh > 0 && !c.config.Clock.Now().Before(e.loadedAt.Add(refresh))
+}
+
+// load returns the load in flight for key, starting one if needed.
+// It must be called with the lock held.
+func (c *Cache[K, V]) load(ctx context.Context, key K) *call[V] {
+	if inflight, ok := c.calls[key]; ok {
+		return inflight
+	}
+	inflight := &call[V]{done: make(chan struct{})}
+	c.calls[key] = inflight
+	go c.run(context.WithoutCancel(ctx), key, inflight)
+	return inflight
+}
+
+// run executes the loader and publishes its result. Waiters are released
+// and the call is cleared even if the loader panics.
+func (c *Cache[K, V]) run(ctx context.Context, key K, inflight *call[V]) {
+	defer close(inflight.done)
+	value, err := c.invoke(ctx, key)
+	inflight.value, inflight.err = value, err
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.calls[key] == inflight {
+		delete(c.calls, key)
+		c.publish(key, value, err)
+	}
+}
+
+// invoke calls the loader, turning a panic into an error wrapping
+// ErrLoaderPanicked so that it reaches the callers instead of crashing
+// the load goroutine.
+func (c *Cache[K, V]) invoke(ctx context.Context, key K) (value V, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			var zero V
+			value, err = zero, fmt.Errorf("%w: %v", ErrLoaderPanicked, r)
+		}
+	}()
+	return c.config.Loader(ctx, key)
+}
+
+// publish caches the outcome of a load. A failed reload of a key that
+// still holds a value keeps that value.
+// It must be called with the lock held.
+func (c *Cache[K, V]) publish(key K, value V, err error) {
+	now := c.config.Clock.Now()
+	if err == nil {
+		c.store.Put(key, &entry[V]{value: value, loadedAt: now})
+		return
+	}
+	if old, ok := c.store.Peek(key); ok && old.err == nil {
+		return
+	}
+	if c.config.NegativeTTL > 0 {
+		c.store.Put(key, &entry[V]{err: err, loadedAt: now})
+	}
+}
+
+// mapStore is an unbounded store.
+type mapStore[K comparable, V any] struct {
+	items map[K]V
+}
+
+func newMapStore[K comparable, V any]() *mapStore[K, V] {
+	return &mapStore[K, V]{items: make(map[K]V)}
+}
+
+func (s *mapStore[K, V]) Peek(key K) (V, bool) {
+	value, ok := s.items[key]
+	return value, ok
+}
+
+func (s *mapStore[K, V]) Get(key K) (V, bool) {
+	return s.Peek(key)
+}
+
+func (s *mapStore[K, V]) Put(key K, value V) {
+	s.items[key] = value
+}
+
+func (s *mapStore[K, V]) Remove(key K) bool {
+	_, ok := s.items[key]
+	delete(s.items, key)
+	return ok
+}
+
+func (s *mapStore[K, V]) Size() int {
+	return len(s.items)
+}
+
+func (s *mapStore[K, V]) Clear() {
+	clear(s.items)
+}
@@ -0,0 +1,254 @@
+package loading_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache/loading"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock is a manually advanced cache.Clock.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// countingLoader returns the key doubled and counts its calls.
+func countingLoader(calls *atomic.Int32) loading.Loader[int, int] {
+	return func(_ context.Context, key int) (int, error) {
+		return key*2 + int(calls.Add(1)) - 1, nil
+	}
+}
+
+func TestCache_DeduplicatesConcurrentLoads(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	c, err := loading.New(loading.Config[string, int]{
+		Loader: func(_ context.Context, key string) (int, error) {
+			calls.Add(1)
+			<-release
+			return len(key), nil
+		},
+	})
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	results := make([]int, 50)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = c.Get(context.Background(), "backend")
+		}(i)
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+	for _, result := range results {
+		assert.Equal(t, 7, result)
+	}
+	value, ok := c.GetIfPresent("backend")
+	assert.True(t, ok)
+	assert.Equal(t, 7, value)
+}
+
+func TestCache_ExpireAfterWrite(t *testing.T) {
+	var calls atomic.Int32
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	c, _ := loading.New(loading.Config[int, int]{
+		Loader:           countingLoader(&calls),
+		ExpireAfterWrite: time.Minute,
+		Clock:            clock,
+	})
+	ctx := context.Background()
+
+	value, _ := c.Get(ctx, 1)
+	assert.Equal(t, 2, value)
+	clock.Advance(30 * time.Second)
+	value, _ = c.Get(ctx, 1)
+	assert.Equal(t, 2, value)
+
+	clock.Advance(30 * time.Second)
+	_, ok := c.GetIfPresent(1)
+	assert.False(t, ok)
+	value, _ = c.Get(ctx, 1)
+	assert.Equal(t, 3, value)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestCache_RefreshAfterWrite(t *testing.T) {
+	var calls atomic.Int32
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	c, _ := loading.New(loading.Config[int, int]{
+		Loader:            countingLoader(&calls),
+		RefreshAfterWrite: time.Minute,
+		Clock:             clock,
+	})
+	ctx := context.Background()
+
+	value, _ := c.Get(ctx, 1)
+	assert.Equal(t, 2, value)
+
+	// A stale value is served while it reloads in the background
+	clock.Advance(time.Minute)
+	value, _ = c.Get(ctx, 1)
+	assert.Equal(t, 2, value)
+	assert.Eventually(t, func() bool {
+		value, _ := c.GetIfPresent(1)
+		return value == 3
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestCache_NegativeCaching(t *testing.T) {
+	errBackend := errors.New("backend down")
+	var calls atomic.Int32
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	loader := func(context.Context, string) (string, error) {
+		calls.Add(1)
+		return "", errBackend
+	}
+
+	c, _ := loading.New(loading.Config[string, string]{Loader: loader, NegativeTTL: time.Second, Clock: clock})
+	ctx := context.Background()
+	_, err := c.Get(ctx, "a")
+	assert.ErrorIs(t, err, errBackend)
+	_, err = c.Get(ctx, "a")
+	assert.ErrorIs(t, err, errBackend)
+	assert.Equal(t, int32(1), calls.Load())
+	_, ok := c.GetIfPresent("a")
+	assert.False(t, ok)
+
+	clock.Advance(time.Second)
+	_, _ = c.Get(ctx, "a")
+	assert.Equal(t, int32(2), calls.Load())
+
+	// Without a negative TTL every Get retries the backend
+	uncached, _ := loading.New(loading.Config[string, string]{Loader: loader})
+	_, _ = uncached.Get(ctx, "a")
+	_, _ = uncached.Get(ctx, "a")
+	assert.Equal(t, int32(4), calls.Load())
+	assert.Equal(t, 0, uncached.Size())
+}
+
+func TestCache_FailedRefreshKeepsValue(t *testing.T) {
+	var fail atomic.Bool
+	c, _ := loading.New(loading.Config[string, int]{
+		Loader: func(context.Context, string) (int, error) {
+			if fail.Load() {
+				return 0, errors.New("backend down")
+			}
+			return 1, nil
+		},
+		NegativeTTL: time.Minute,
+	})
+
+	value, _ := c.Get(context.Background(), "a")
+	assert.Equal(t, 1, value)
+	fail.Store(true)
+	c.Refresh("a")
+	time.Sleep(10 * time.Millisecond)
+
+	value, err := c.Get(context.Background(), "a")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, value)
+}
+
+func TestCache_LoaderPanic(t *testing.T) {
+	var panics atomic.Bool
+	panics.Store(true)
+	c, _ := loading.New(loading.Config[string, int]{
+		Loader: func(context.Context, string) (int, error) {
+			if panics.Load() {
+				panic("boom")
+			}
+			return 7, nil
+		},
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.Get(context.Background(), "a")
+			assert.ErrorIs(t, err, loading.ErrLoaderPanicked)
+		}()
+	}
+	wg.Wait()
+
+	// The failed call is cleared, so the next Get loads again
+	panics.Store(false)
+	value, err := c.Get(context.Background(), "a")
+	assert.NoError(t, err)
+	assert.Equal(t, 7, value)
+}
+
+func TestCache_WaiterCancellation(t *testing.T) {
+	release := make(chan struct{})
+	c, _ := loading.New(loading.Config[int, int]{
+		Loader: func(context.Context, int) (int, error) {
+			<-release
+			return 42, nil
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := c.Get(ctx, 1)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// The load started by the cancelled caller still completes for others
+	close(release)
+	value, err := c.Get(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 42, value)
+	assert.Equal(t, uint64(2), c.Stats().Misses)
+}
+
+func TestCache_InvalidateAndPut(t *testing.T) {
+	var calls atomic.Int32
+	c, _ := loading.New(loading.Config[int, int]{Loader: countingLoader(&calls), Capacity: 2})
+	ctx := context.Background()
+
+	c.Put(1, 100)
+	value, _ := c.Get(ctx, 1)
+	assert.Equal(t, 100, value)
+	assert.Equal(t, int32(0), calls.Load())
+
+	c.Invalidate(1)
+	value, _ = c.Get(ctx, 1)
+	assert.Equal(t, 2, value)
+
+	_, _ = c.Get(ctx, 2)
+	_, _ = c.Get(ctx, 3)
+	assert.Equal(t, 2, c.Size())
+	assert.Equal(t, uint64(1), c.Stats().Evictions)
+
+	c.InvalidateAll()
+	assert.Equal(t, 0, c.Size())
+}
+
+func TestNew_RequiresLoader(t *testing.T) {
+	_, err := loading.New(loading.Config[int, int]{})
+	assert.ErrorIs(t, err, loading.ErrNoLoader)
+}
@@ -0,0 +1,133 @@
+package lru
+
+import (
+	"container/list"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache"
+)
+
+// entry is a cached key-value pair.
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// LRU is a cache that evicts the least recently used entry.
+type LRU[K comparable, V any] struct {
+	capacity int
+	items    map[K]*list.Element
+	order    *list.List
+	onEvict  cache.EvictionFunc[K, V]
+	stats    cache.Stats
+}
+
+// New creates an LRU cache holding at most capacity entries.
+// onEvict may be nil. It panics if capacity is not positive.
+func New[K comparable, V any](capacity int, onEvict cache.EvictionFunc[K, V]) *LRU[K, V] {
+	if capacity <= 0 {
+		panic("lru: capacity must be positive")
+	}
+	return &LRU[K, V]{
+		capacity: capacity,
+		items:    make(map[K]*list.Element),
+		order:    list.New(),
+		onEvict:  onEvict,
+	}
+}
+
+// NewSync creates a thread-safe LRU cache.
+func NewSync[K comparable, V any](capacity int, onEvict cache.EvictionFunc[K, V]) *cache.SyncCache[K, V] {
+	return cache.NewSync[K, V](New(capacity, onEvict))
+}
+
+// Get returns the value stored for key and marks it as most recently used.
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	elem, exists := c.items[key]
+	if !exists {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.order.MoveToFront(elem)
+	return elem.Value.(*entry[K, V]).value, true
+}
+
+// Peek returns the value stored for key without touching its recency.
+func (c *LRU[K, V]) Peek(key K) (V, bool) {
+	if elem, exists := c.items[key]; exists {
+		return elem.Value.(*entry[K, V]).value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Put stores value for key and marks it as most recently used.
+func (c *LRU[K, V]) Put(key K, value V) {
+	if elem, exists := c.items[key]; exists {
+		elem.Value.(*entry[K, V]).value = value
+		c.order.MoveToFront(elem)
+		return
+	}
+	if len(c.items) >= c.capacity {
+		c.evict()
+	}
+	c.items[key] = c.order.PushFront(&entry[K, V]{key: key, value: value})
+}
+
+// Remove deletes key and reports whether it was present.
+func (c *LRU[K, V]) Remove(key K) bool {
+	elem, exists := c.items[key]
+	if exists {
+		c.order.Remove(elem)
+		delete(c.items, key)
+	}
+	return exists
+}
+
+// Contains checks if key is cached.
+func (c *LRU[K, V]) Contains(key K) bool {
+	_, exists := c.items[key]
+	return exists
+}
+
+// Keys returns the cached keys from most to least recently used.
+func (c *LRU[K, V]) Keys() []K {
+	keys := make([]K, 0, len(c.items))
+	for elem := c.order.Front(); elem != nil; elem = elem.Next() {
+		keys = append(keys, elem.Value.(*entry[K, V]).key)
+	}
+	return keys
+}
+
+// Size returns the number of cached entries.
+func (c *LRU[K, V]) Size() int {
+	return len(c.items)
+}
+
+// Capacity returns the maximum number of cached entries.
+func (c *LRU[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Clear removes all entries.
+func (c *LRU[K, V]) Clear() {
+	c.items = make(map[K]*list.Element)
+	c.order.Init()
+}
+
+// Stats returns a snapshot of the cache statistics.
+func (c *LRU[K, V]) Stats() cache.Stats {
+	return c.stats
+}
+
+// evict drops the least recently used entry.
+func (c *LRU[K, V]) evict() {
+	elem := c.order.Back()
+	e := c.order.Remove(elem).(*entry[K, V])
+	delete(c.items, e.key)
+	c.stats.Evictions++
+	if c.onEvict != nil {
+		c.onEvict(e.key, e.value)
+	}
+}
@@ -0,0 +1,65 @@
+package lru_test
+
+import (
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache/lru"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRU_EvictsLeastRecentlyUsed(t *testing.T) {
+	var evicted []string
+	c := lru.New[string, int](2, func(key string, _ int) {
+		evicted = append(evicted, key)
+	})
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	_, _ = c.Get("a")
+	c.Put("c", 3)
+
+	assert.Equal(t, []string{"b"}, evicted)
+	assert.Equal(t, []string{"c", "a"}, c.Keys())
+	assert.False(t, c.Contains("b"))
+}
+
+func TestLRU_BasicOperations(t *testing.T) {
+	var c cache.Cache[string, int] = lru.New[string, int](3, nil)
+
+	c.Put("a", 1)
+	c.Put("a", 10)
+	value, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 10, value)
+	_, ok = c.Get("missing")
+	assert.False(t, ok)
+
+	value, ok = c.Peek("a")
+	assert.True(t, ok)
+	assert.Equal(t, 10, value)
+	assert.Equal(t, cache.Stats{Hits: 1, Misses: 1}, c.Stats())
+
+	assert.True(t, c.Remove("a"))
+	assert.False(t, c.Remove("a"))
+	assert.Equal(t, 0, c.Size())
+	assert.Equal(t, 3, c.Capacity())
+
+	c.Put("b", 2)
+	c.Clear()
+	assert.Equal(t, 0, c.Size())
+}
+
+func TestLRU_PeekKeepsOrder(t *testing.T) {
+	c := lru.New[int, int](2, nil)
+	c.Put(1, 1)
+	c.Put(2, 2)
+	_, _ = c.Peek(1)
+	c.Put(3, 3)
+
+	assert.False(t, c.Contains(1))
+}
+
+func TestLRU_InvalidCapacity(t *testing.T) {
+	assert.Panics(t, func() { lru.New[int, int](0, nil) })
+}
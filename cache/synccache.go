@@ -0,0 +1,77 @@
+package cache
+
+import "sync"
+
+// SyncCache makes any Cache safe for concurrent use.
+// Every operation takes one mutex, since lookups reorder entries too.
+// Eviction callbacks run under the lock and must not call back into the cache.
+type SyncCache[K comparable, V any] struct {
+	cache Cache[K, V]
+	mu    sync.Mutex
+}
+
+// NewSync wraps c in a SyncCache. c must not be used directly afterwards.
+func NewSync[K comparable, V any](c Cache[K, V]) *SyncCache[K, V] {
+	return &SyncCache[K, V]{cache: c}
+}
+
+// Get returns the value stored for key and records a hit or a miss.
+func (s *SyncCache[K, V]) Get(key K) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.Get(key)
+}
+
+// Peek returns the value stored for key without side effects.
+func (s *SyncCache[K, V]) Peek(key K) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.Peek(key)
+}
+
+// Put stores value for key.
+func (s *SyncCache[K, V]) Put(key K, value V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cache.Put(key, value)
+}
+
+// Remove deletes key and reports whether it was present.
+func (s *SyncCache[K, V]) Remove(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.Remove(key)
+}
+
+// Contains checks if key is cached.
+func (s *SyncCache[K, V]) Contains(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.Contains(key)
+}
+
+// Size returns the number of cached entries.
+func (s *SyncCache[K, V]) Size() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.Size()
+}
+
+// Capacity returns the maximum number of cached entries.
+func (s *SyncCache[K, V]) Capacity() int {
+	return s.cache.Capacity()
+}
+
+// Clear removes all entries.
+func (s *SyncCache[K, V]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cache.Clear()
+}
+
+// Stats returns a snapshot of the cache statistics.
+func (s *SyncCache[K, V]) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cache.Stats()
+}
@@ -0,0 +1,61 @@
+package cache_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache/arc"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache/lfu"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache/lru"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache/twoq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncCache_ConcurrentAccess(t *testing.T) {
+	caches := map[string]interface {
+		Get(key int) (int, bool)
+		Put(key, value int)
+		Size() int
+	}{
+		"LRU": lru.NewSync[int, int](50, nil),
+		"LFU": lfu.NewSync[int, int](50, nil),
+		"ARC": arc.NewSync[int, int](50, nil),
+		"2Q":  twoq.NewSync[int, int](50, nil),
+	}
+
+	for name, c := range caches {
+		var wg sync.WaitGroup
+		for g := 0; g < 4; g++ {
+			wg.Add(1)
+			go func(g int) {
+				defer wg.Done()
+				for i := 0; i < 500; i++ {
+					key := (g*31 + i) % 80
+					if _, ok := c.Get(key); !ok {
+						c.Put(key, i)
+					}
+				}
+			}(g)
+		}
+		wg.Wait()
+		assert.LessOrEqual(t, c.Size(), 50, name)
+	}
+}
+
+func TestSyncCache_Delegates(t *testing.T) {
+	c := lru.NewSync[string, int](2, nil)
+	c.Put("a", 1)
+
+	value, ok := c.Peek("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+	assert.True(t, c.Contains("a"))
+	assert.Equal(t, 2, c.Capacity())
+	assert.True(t, c.Remove("a"))
+
+	c.Put("b", 2)
+	_, _ = c.Get("b")
+	assert.Equal(t, uint64(1), c.Stats().Hits)
+	c.Clear()
+	assert.Equal(t, 0, c.Size())
+}
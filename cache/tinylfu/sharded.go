@@ -0,0 +1,152 @@
+package tinylfu
+
+import (
+	"sync"
+	"time"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache"
+)
+
+// shard is one independently locked part of a Sharded cache.
+type shard[K comparable, V any] struct {
+	cache *Cache[K, V]
+	mu    sync.Mutex
+}
+
+// Sharded is a concurrent cost-bounded cache. Keys are spread over
+// several independently locked caches by hash, each holding an equal
+// share of MaxCost, so that goroutines working on different keys rarely
+// contend. Eviction callbacks run under a shard lock and must not call
+// back into the cache.
+type Sharded[K comparable, V any] struct {
+	shards []*shard[K, V]
+	hash   func(key K) uint64
+}
+
+// NewSharded creates a concurrent cache split into shards parts.
+// It panics if MaxCost is smaller than the number of shards.
+func NewSharded[K comparable, V any](config Config[K, V], shards int) *Sharded[K, V] {
+	shards = max(shards, 1)
+	if config.MaxCost < int64(shards) {
+		panic("tinylfu: MaxCost must be at least the number of shards")
+	}
+	if config.Hash == nil {
+		config.Hash = defaultHash[K]()
+	}
+	shardConfig := config
+	shardConfig.MaxCost = config.MaxCost / int64(shards)
+	shardConfig.NumCounters = max(config.NumCounters/shards, 0)
+	s := &Sharded[K, V]{
+		shards: make([]*shard[K, V], shards),
+		hash:   config.Hash,
+	}
+	for i := range s.shards {
+		s.shards[i] = &shard[K, V]{cache: New(shardConfig)}
+	}
+	return s
+}
+
+// Get returns the value stored for key and records the access.
+func (s *Sharded[K, V]) Get(key K) (V, bool) {
+	sh := s.shardFor(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return sh.cache.Get(key)
+}
+
+// Peek returns the value stored for key without recording an access.
+func (s *Sharded[K, V]) Peek(key K) (V, bool) {
+	sh := s.shardFor(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return sh.cache.Peek(key)
+}
+
+// Put stores value with the cost given by Config.Cost.
+func (s *Sharded[K, V]) Put(key K, value V) {
+	sh := s.shardFor(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	sh.cache.Put(key, value)
+}
+
+// Set stores value with the given cost, which must fit in one shard.
+func (s *Sharded[K, V]) Set(key K, value V, cost int64) bool {
+	return s.SetWithTTL(key, value, cost, 0)
+}
+
+// SetWithTTL is like Set but the entry expires after ttl.
+func (s *Sharded[K, V]) SetWithTTL(key K, value V, cost int64, ttl time.Duration) bool {
+	sh := s.shardFor(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return sh.cache.SetWithTTL(key, value, cost, ttl)
+}
+
+// Remove deletes key and reports whether it was present.
+func (s *Sharded[K, V]) Remove(key K) bool {
+	sh := s.shardFor(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return sh.cache.Remove(key)
+}
+
+// Contains checks if key is cached and not expired.
+func (s *Sharded[K, V]) Contains(key K) bool {
+	sh := s.shardFor(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return sh.cache.Contains(key)
+}
+
+// Size returns the number of cached entries across all shards.
+func (s *Sharded[K, V]) Size() int {
+	size := 0
+	s.each(func(c *Cache[K, V]) { size += c.Size() })
+	return size
+}
+
+// Capacity returns the combined MaxCost of all shards.
+func (s *Sharded[K, V]) Capacity() int {
+	capacity := 0
+	s.each(func(c *Cache[K, V]) { capacity += c.Capacity() })
+	return capacity
+}
+
+// Cost returns the total cost of the cached entries across all shards.
+func (s *Sharded[K, V]) Cost() int64 {
+	var cost int64
+	s.each(func(c *Cache[K, V]) { cost += c.Cost() })
+	return cost
+}
+
+// Clear removes all entries from every shard.
+func (s *Sharded[K, V]) Clear() {
+	s.each(func(c *Cache[K, V]) { c.Clear() })
+}
+
+// Stats returns the sum of the statistics of all shards.
+func (s *Sharded[K, V]) Stats() cache.Stats {
+	var stats cache.Stats
+	s.each(func(c *Cache[K, V]) {
+		shardStats := c.Stats()
+		stats.Hits += shardStats.Hits
+		stats.Misses += shardStats.Misses
+		stats.Evictions += shardStats.Evictions
+	})
+	return stats
+}
+
+// shardFor returns the shard responsible for key.
+func (s *Sharded[K, V]) shardFor(key K) *shard[K, V] {
+	return s.shards[s.hash(key)%uint64(len(s.shards))]
+}
+
+// each calls fn for every shard while holding its lock.
+func (s *Sharded[K, V]) each(fn func(c *Cache[K, V])) {
+	for _, sh := range s.shards {
+		sh.mu.Lock()
+		fn(sh.cache)
+		sh.mu.Unlock()
+	}
+}
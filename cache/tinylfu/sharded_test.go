@@ -0,0 +1,59 @@
+package tinylfu_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache/tinylfu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSharded_BasicOperations(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	c := tinylfu.NewSharded(tinylfu.Config[int, string]{MaxCost: 400, Hash: intHash, Clock: clock}, 4)
+	assert.Equal(t, 400, c.Capacity())
+
+	assert.True(t, c.Set(1, "one", 5))
+	c.Put(2, "two")
+	c.SetWithTTL(3, "three", 1, time.Second)
+	assert.Equal(t, 3, c.Size())
+	assert.Equal(t, int64(7), c.Cost())
+
+	value, ok := c.Get(1)
+	assert.True(t, ok)
+	assert.Equal(t, "one", value)
+	_, ok = c.Peek(2)
+	assert.True(t, ok)
+
+	clock.Advance(time.Second)
+	assert.False(t, c.Contains(3))
+	assert.True(t, c.Remove(2))
+	assert.Equal(t, uint64(1), c.Stats().Hits)
+
+	c.Clear()
+	assert.Equal(t, 0, c.Size())
+}
+
+func TestSharded_ConcurrentAccess(t *testing.T) {
+	c := tinylfu.NewSharded(tinylfu.Config[int, int]{MaxCost: 256, Hash: intHash}, 8)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				key := (g*997 + i) % 500
+				if _, ok := c.Get(key); !ok {
+					c.Set(key, i, int64(1+key%4))
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, c.Cost(), int64(256))
+	stats := c.Stats()
+	assert.Equal(t, uint64(8000), stats.Hits+stats.Misses)
+}
@@ -0,0 +1,84 @@
+package tinylfu
+
+import "math/bits"
+
+// sketchDepth is the number of rows in the Count-Min sketch.
+const sketchDepth = 4
+
+// maxCount is the saturation point of a counter, as in the 4-bit
+// counters of the original TinyLFU design.
+const maxCount = 15
+
+// rowSeeds decorrelate the index computed for each row.
+var rowSeeds = [sketchDepth]uint64{
+	0xc3a5c85c97cb3127, 0xb492b66fbe98f273, 0x9ae16a3b2f90404f, 0xcbf29ce484222325,
+}
+
+// countMinSketch estimates how often keys were seen recently. All counters
+// are halved once the number of increments reaches the sample size, so
+// that old popularity fades.
+type countMinSketch struct {
+	rows       [sketchDepth][]uint8
+	shift      uint
+	additions  int
+	sampleSize int
+}
+
+// newCountMinSketch creates a sketch sized for about counters distinct keys.
+func newCountMinSketch(counters int) *countMinSketch {
+	width := 1 << bits.Len(uint(max(counters, 16)-1))
+	s := &countMinSketch{
+		shift:      uint(64 - bits.Len(uint(width-1))),
+		sampleSize: 10 * width,
+	}
+	for i := range s.rows {
+		s.rows[i] = make([]uint8, width)
+	}
+	return s
+}
+
+// increment records one occurrence of the key with the given hash.
+func (s *countMinSketch) increment(hash uint64) {
+	for i := range s.rows {
+		idx := s.index(i, hash)
+		if s.rows[i][idx] < maxCount {
+			s.rows[i][idx]++
+		}
+	}
+	s.additions++
+	if s.additions >= s.sampleSize {
+		s.reset()
+	}
+}
+
+// estimate returns the approximate number of recent occurrences.
+func (s *countMinSketch) estimate(hash uint64) uint8 {
+	estimate := uint8(maxCount)
+	for i := range s.rows {
+		estimate = min(estimate, s.rows[i][s.index(i, hash)])
+	}
+	return estimate
+}
+
+// reset halves every counter.
+func (s *countMinSketch) reset() {
+	for i := range s.rows {
+		for j := range s.rows[i] {
+			s.rows[i][j] >>= 1
+		}
+	}
+	s.additions /= 2
+}
+
+// clear zeroes every counter.
+func (s *countMinSketch) clear() {
+	for i := range s.rows {
+		clear(s.rows[i])
+	}
+	s.additions = 0
+}
+
+// index returns the counter of row i for hash.
+func (s *countMinSketch) index(i int, hash uint64) uint64 {
+	return ((hash ^ rowSeeds[i]) * 0x9e3779b97f4a7c15) >> s.shift
+}
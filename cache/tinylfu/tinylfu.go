@@ -0,0 +1,352 @@
+package tinylfu
+
+import (
+	"container/list"
+	"fmt"
+	"hash/maphash"
+	"time"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache"
+)
+
+// segment identifies the region of the cache an entry lives in.
+type segment uint8
+
+const (
+	window segment = iota
+	probation
+	protected
+)
+
+// Config describes a cost-bounded cache.
+type Config[K comparable, V any] struct {
+	// MaxCost is the total cost the cache may hold. It must be positive.
+	MaxCost int64
+
+	// NumCounters sizes the frequency sketch and should be close to the
+	// number of distinct keys expected in the cache. Defaults to MaxCost.
+	NumCounters int
+
+	// Cost returns the cost used by Put. Defaults to 1 for every value.
+	Cost func(value V) int64
+
+	// Hash returns a well distributed hash of a key. The default hashes
+	// the key's fmt representation, which is slow; set it for hot paths.
+	Hash func(key K) uint64
+
+	// Clock is used for expiry. Defaults to cache.SystemClock.
+	Clock cache.Clock
+
+	// OnEvict is called with every entry evicted, rejected or expired.
+	OnEvict cache.EvictionFunc[K, V]
+}
+
+// entry is a cached key-value pair.
+type entry[K comparable, V any] struct {
+	key      K
+	value    V
+	cost     int64
+	hash     uint64
+	expireAt time.Time
+	segment  segment
+	elem     *list.Element
+}
+
+// Cache is a cost-bounded cache using the W-TinyLFU policy. New entries
+// enter a small LRU window; entries leaving the window must beat the main
+// region's eviction victim on estimated frequency to be admitted. The main
+// region is a segmented LRU with a probation and a protected part.
+// Cache is not safe for concurrent use; see Sharded.
+type Cache[K comparable, V any] struct {
+	window, probation, protected *list.List
+
+	windowCost, mainCost, protectedCost int64
+	windowMax, mainMax, protectedMax    int64
+
+	items  map[K]*entry[K, V]
+	sketch *countMinSketch
+	config Config[K, V]
+	stats  cache.Stats
+}
+
+// New creates a cost-bounded cache. It panics if MaxCost is not positive.
+func New[K comparable, V any](config Config[K, V]) *Cache[K, V] {
+	if config.MaxCost <= 0 {
+		panic("tinylfu: MaxCost must be positive")
+	}
+	if config.NumCounters <= 0 {
+		config.NumCounters = int(min(config.MaxCost, 1<<24))
+	}
+	if config.Cost == nil {
+		config.Cost = func(V) int64 { return 1 }
+	}
+	if config.Hash == nil {
+		config.Hash = defaultHash[K]()
+	}
+	if config.Clock == nil {
+		config.Clock = cache.SystemClock
+	}
+	windowMax := max(1, config.MaxCost/100)
+	mainMax := config.MaxCost - windowMax
+	return &Cache[K, V]{
+		window:       list.New(),
+		probation:    list.New(),
+		protected:    list.New(),
+		windowMax:    windowMax,
+		mainMax:      mainMax,
+		protectedMax: mainMax * 8 / 10,
+		items:        make(map[K]*entry[K, V]),
+		sketch:       newCountMinSketch(config.NumCounters),
+		config:       config,
+	}
+}
+
+// Get returns the value stored for key and records the access.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	e, exists := c.items[key]
+	if !exists {
+		c.sketch.increment(c.config.Hash(key))
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.sketch.increment(e.hash)
+	if c.expired(e) {
+		c.evict(e)
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.touch(e)
+	return e.value, true
+}
+
+// Peek returns the value stored for key without recording an access.
+func (c *Cache[K, V]) Peek(key K) (V, bool) {
+	if e, exists := c.items[key]; exists && !c.expired(e) {
+		return e.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Put stores value with the cost given by Config.Cost.
+func (c *Cache[K, V]) Put(key K, value V) {
+	c.Set(key, value, c.config.Cost(value))
+}
+
+// Set stores value with the given cost. It returns false, leaving the
+// cache unchanged, if the cost is not positive or larger than the main
+// region, which is MaxCost less the admission window. An accepted entry
+// may still be evicted right away if it loses the admission contest.
+func (c *Cache[K, V]) Set(key K, value V, cost int64) bool {
+	return c.SetWithTTL(key, value, cost, 0)
+}
+
+// SetWithTTL is like Set but the entry expires after ttl.
+// A non-positive ttl means the entry never expires.
+func (c *Cache[K, V]) SetWithTTL(key K, value V, cost int64, ttl time.Duration) bool {
+	if cost <= 0 || cost > max(c.mainMax, c.windowMax) {
+		return false
+	}
+	var expireAt time.Time
+	if ttl > 0 {
+		expireAt = c.config.Clock.Now().Add(ttl)
+	}
+
+	if e, exists := c.items[key]; exists {
+		c.sketch.increment(e.hash)
+		e.value = value
+		e.expireAt = expireAt
+		c.addCost(e, cost-e.cost)
+		e.cost = cost
+		c.touch(e)
+		c.shrinkMain()
+		c.shrinkWindow()
+		return true
+	}
+
+	hash := c.config.Hash(key)
+	c.sketch.increment(hash)
+	e := &entry[K, V]{key: key, value: value, cost: cost, hash: hash, expireAt: expireAt, segment: window}
+	e.elem = c.window.PushFront(e)
+	c.windowCost += cost
+	c.items[key] = e
+	c.shrinkWindow()
+	return true
+}
+
+// Remove deletes key and reports whether it was present.
+func (c *Cache[K, V]) Remove(key K) bool {
+	e, exists := c.items[key]
+	if exists {
+		c.unlink(e)
+	}
+	return exists
+}
+
+// Contains checks if key is cached and not expired.
+func (c *Cache[K, V]) Contains(key K) bool {
+	e, exists := c.items[key]
+	return exists && !c.expired(e)
+}
+
+// Size returns the number of cached entries.
+func (c *Cache[K, V]) Size() int {
+	return len(c.items)
+}
+
+// Capacity returns MaxCost, the bound of this cache.
+func (c *Cache[K, V]) Capacity() int {
+	return int(c.config.MaxCost)
+}
+
+// Cost returns the total cost of the cached entries.
+func (c *Cache[K, V]) Cost() int64 {
+	return c.windowCost + c.mainCost
+}
+
+// Clear removes all entries and forgets the access history.
+func (c *Cache[K, V]) Clear() {
+	c.window.Init()
+	c.probation.Init()
+	c.protected.Init()
+	c.windowCost, c.mainCost, c.protectedCost = 0, 0, 0
+	c.items = make(map[K]*entry[K, V])
+	c.sketch.clear()
+}
+
+// Stats returns a snapshot of the cache statistics. Rejected and
+// expired entries count as evictions.
+func (c *Cache[K, V]) Stats() cache.Stats {
+	return c.stats
+}
+
+// touch refreshes the recency of e, promoting probation entries.
+func (c *Cache[K, V]) touch(e *entry[K, V]) {
+	switch e.segment {
+	case window:
+		c.window.MoveToFront(e.elem)
+	case protected:
+		c.protected.MoveToFront(e.elem)
+	case probation:
+		c.probation.Remove(e.elem)
+		e.segment = protected
+		e.elem = c.protected.PushFront(e)
+		c.protectedCost += e.cost
+		for c.protectedCost > c.protectedMax {
+			demoted := c.protected.Back().Value.(*entry[K, V])
+			c.protected.Remove(demoted.elem)
+			c.protectedCost -= demoted.cost
+			demoted.segment = probation
+			demoted.elem = c.probation.PushFront(demoted)
+		}
+	}
+}
+
+// shrinkWindow moves entries out of an overfull window, each of which
+// then competes for admission to the main region.
+func (c *Cache[K, V]) shrinkWindow() {
+	for c.windowCost > c.windowMax {
+		candidate := c.window.Back().Value.(*entry[K, V])
+		c.window.Remove(candidate.elem)
+		c.windowCost -= candidate.cost
+		c.admit(candidate)
+	}
+}
+
+// admit moves candidate into probation if it is estimated to be used more
+// often than the entries it would displace; otherwise it is evicted.
+func (c *Cache[K, V]) admit(candidate *entry[K, V]) {
+	// Evicting the whole main region would still not make room
+	if candidate.cost > c.mainMax {
+		c.evicted(candidate)
+		return
+	}
+	for c.mainCost+candidate.cost > c.mainMax {
+		victim := c.victim()
+		if victim == nil || c.sketch.estimate(candidate.hash) <= c.sketch.estimate(victim.hash) {
+			c.evicted(candidate)
+			return
+		}
+		c.evict(victim)
+	}
+	candidate.segment = probation
+	candidate.elem = c.probation.PushFront(candidate)
+	c.mainCost += candidate.cost
+}
+
+// shrinkMain evicts main region entries until it fits again, which is only
+// needed after an existing entry grew.
+func (c *Cache[K, V]) shrinkMain() {
+	for c.mainCost > c.mainMax {
+		c.evict(c.victim())
+	}
+}
+
+// victim returns the next main region entry to evict.
+func (c *Cache[K, V]) victim() *entry[K, V] {
+	if elem := c.probation.Back(); elem != nil {
+		return elem.Value.(*entry[K, V])
+	}
+	if elem := c.protected.Back(); elem != nil {
+		return elem.Value.(*entry[K, V])
+	}
+	return nil
+}
+
+// addCost adjusts the cost totals of the segment holding e.
+func (c *Cache[K, V]) addCost(e *entry[K, V], delta int64) {
+	switch e.segment {
+	case window:
+		c.windowCost += delta
+	case protected:
+		c.protectedCost += delta
+		c.mainCost += delta
+	case probation:
+		c.mainCost += delta
+	}
+}
+
+// evict unlinks e and reports it as evicted.
+func (c *Cache[K, V]) evict(e *entry[K, V]) {
+	c.unlink(e)
+	c.evicted(e)
+}
+
+// evicted counts the eviction of an already unlinked entry.
+func (c *Cache[K, V]) evicted(e *entry[K, V]) {
+	delete(c.items, e.key)
+	c.stats.Evictions++
+	if c.config.OnEvict != nil {
+		c.config.OnEvict(e.key, e.value)
+	}
+}
+
+// unlink removes e from its segment and the index.
+func (c *Cache[K, V]) unlink(e *entry[K, V]) {
+	switch e.segment {
+	case window:
+		c.window.Remove(e.elem)
+	case probation:
+		c.probation.Remove(e.elem)
+	case protected:
+		c.protected.Remove(e.elem)
+	}
+	c.addCost(e, -e.cost)
+	delete(c.items, e.key)
+}
+
+// expired reports whether e has outlived its time to live.
+func (c *Cache[K, V]) expired(e *entry[K, V]) bool {
+	return !e.expireAt.IsZero() && !c.config.Clock.Now().Before(e.expireAt)
+}
+
+// defaultHash hashes the fmt representation of a key.
+func defaultHash[K comparable]() func(K) uint64 {
+	seed := maphash.MakeSeed()
+	return func(key K) uint64 {
+		return maphash.String(seed, fmt.Sprint(key))
+	}
+}
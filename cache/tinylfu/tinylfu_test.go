@@ -0,0 +1,152 @@
+package tinylfu_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache/tinylfu"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock is a manually advanced cache.Clock.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func intHash(key int) uint64 {
+	return uint64(key) * 0x9e3779b97f4a7c15
+}
+
+func TestCache_CostBound(t *testing.T) {
+	c := tinylfu.New(tinylfu.Config[int, string]{MaxCost: 100, Hash: intHash})
+
+	for key := 0; key < 50; key++ {
+		assert.True(t, c.Set(key, "value", 10))
+		assert.LessOrEqual(t, c.Cost(), int64(100))
+	}
+	assert.LessOrEqual(t, c.Size(), 10)
+
+	// Entries larger than the whole cache are rejected outright
+	assert.False(t, c.Set(99, "huge", 101))
+	assert.False(t, c.Contains(99))
+	assert.False(t, c.Set(98, "free", 0))
+	assert.False(t, c.Set(97, "negative", -5))
+	assert.LessOrEqual(t, c.Cost(), int64(100))
+}
+
+func TestCache_OversizedFrequentKeyKeepsCache(t *testing.T) {
+	c := tinylfu.New(tinylfu.Config[string, int]{MaxCost: 100})
+	for i := 0; i < 90; i++ {
+		assert.True(t, c.Set(strconv.Itoa(i), i, 1))
+	}
+	for i := 0; i < 20; i++ {
+		c.Get("big")
+	}
+
+	// A cost within MaxCost but above the main region can never be
+	// admitted, so it must not displace what is already cached
+	assert.False(t, c.Set("big", 0, 100))
+	assert.False(t, c.Contains("big"))
+	assert.Equal(t, 90, c.Size())
+	assert.Equal(t, int64(90), c.Cost())
+}
+
+func TestCache_AdmissionFavoursFrequentKeys(t *testing.T) {
+	var evicted []int
+	c := tinylfu.New(tinylfu.Config[int, int]{
+		MaxCost:     10,
+		NumCounters: 1024,
+		Hash:        intHash,
+		OnEvict:     func(key, _ int) { evicted = append(evicted, key) },
+	})
+
+	// Make keys 0..8 popular
+	for round := 0; round < 5; round++ {
+		for key := 0; key < 9; key++ {
+			if _, ok := c.Get(key); !ok {
+				c.Put(key, key)
+			}
+		}
+	}
+
+	// A stream of one-off keys must not displace them
+	for key := 100; key < 200; key++ {
+		c.Put(key, key)
+	}
+	for key := 0; key < 9; key++ {
+		assert.True(t, c.Contains(key), "popular key %d was evicted", key)
+	}
+	assert.NotEmpty(t, evicted)
+	assert.Equal(t, uint64(len(evicted)), c.Stats().Evictions)
+}
+
+func TestCache_UpdateCost(t *testing.T) {
+	c := tinylfu.New(tinylfu.Config[string, int]{MaxCost: 10})
+
+	c.Set("a", 1, 2)
+	c.Set("b", 2, 2)
+	assert.Equal(t, int64(4), c.Cost())
+
+	c.Set("a", 3, 5)
+	assert.Equal(t, int64(7), c.Cost())
+	value, ok := c.Peek("a")
+	assert.True(t, ok)
+	assert.Equal(t, 3, value)
+
+	assert.True(t, c.Remove("b"))
+	assert.Equal(t, int64(5), c.Cost())
+}
+
+func TestCache_Expiry(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	c := tinylfu.New(tinylfu.Config[string, int]{MaxCost: 10, Clock: clock})
+
+	c.SetWithTTL("a", 1, 1, time.Minute)
+	c.Set("b", 2, 1)
+
+	clock.Advance(59 * time.Second)
+	_, ok := c.Get("a")
+	assert.True(t, ok)
+
+	clock.Advance(time.Second)
+	assert.False(t, c.Contains("a"))
+	_, ok = c.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 1, c.Size())
+	_, ok = c.Get("b")
+	assert.True(t, ok)
+}
+
+func TestCache_BasicOperations(t *testing.T) {
+	var c cache.Cache[string, []byte] = tinylfu.New(tinylfu.Config[string, []byte]{
+		MaxCost: 1024,
+		Cost:    func(value []byte) int64 { return int64(len(value)) },
+	})
+
+	c.Put("a", make([]byte, 100))
+	value, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Len(t, value, 100)
+	_, ok = c.Get("b")
+	assert.False(t, ok)
+	assert.Equal(t, cache.Stats{Hits: 1, Misses: 1}, c.Stats())
+	assert.Equal(t, 1024, c.Capacity())
+
+	c.Clear()
+	assert.Equal(t, 0, c.Size())
+	assert.False(t, c.Remove("a"))
+}
+
+func TestCache_InvalidConfig(t *testing.T) {
+	assert.Panics(t, func() { tinylfu.New(tinylfu.Config[int, int]{}) })
+}
@@ -0,0 +1,197 @@
+package twoq
+
+import (
+	"container/list"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache"
+)
+
+// entry is a key tracked by one of the three 2Q queues. Entries in the
+// ghost queue keep only their key.
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+	owner *list.List
+}
+
+// TwoQ is the full 2Q cache. New keys enter the FIFO queue A1in; keys
+// evicted from it are remembered in the ghost queue A1out, and only keys
+// requested again while remembered are admitted to the LRU queue Am.
+// This keeps one-off scans from flushing frequently used entries.
+type TwoQ[K comparable, V any] struct {
+	capacity int
+	inSize   int // the target size of A1in
+	outSize  int // the maximum size of A1out
+	a1in     *list.List
+	a1out    *list.List
+	am       *list.List
+	items    map[K]*list.Element
+	onEvict  cache.EvictionFunc[K, V]
+	stats    cache.Stats
+}
+
+// New creates a 2Q cache holding at most capacity entries, using the
+// recommended split of a quarter of the capacity for A1in and ghost
+// history for half the capacity. onEvict may be nil.
+// It panics if capacity is not positive.
+func New[K comparable, V any](capacity int, onEvict cache.EvictionFunc[K, V]) *TwoQ[K, V] {
+	return NewWithRatios(capacity, 0.25, 0.5, onEvict)
+}
+
+// NewWithRatios creates a 2Q cache with A1in sized at inRatio of the
+// capacity and A1out remembering outRatio of the capacity in keys.
+func NewWithRatios[K comparable, V any](capacity int, inRatio, outRatio float64, onEvict cache.EvictionFunc[K, V]) *TwoQ[K, V] {
+	if capacity <= 0 {
+		panic("twoq: capacity must be positive")
+	}
+	return &TwoQ[K, V]{
+		capacity: capacity,
+		inSize:   max(1, int(float64(capacity)*inRatio)),
+		outSize:  max(1, int(float64(capacity)*outRatio)),
+		a1in:     list.New(),
+		a1out:    list.New(),
+		am:       list.New(),
+		items:    make(map[K]*list.Element),
+		onEvict:  onEvict,
+	}
+}
+
+// NewSync creates a thread-safe 2Q cache.
+func NewSync[K comparable, V any](capacity int, onEvict cache.EvictionFunc[K, V]) *cache.SyncCache[K, V] {
+	return cache.NewSync[K, V](New(capacity, onEvict))
+}
+
+// Get returns the value stored for key. Hits in Am refresh recency;
+// hits in A1in leave the FIFO order alone.
+func (c *TwoQ[K, V]) Get(key K) (V, bool) {
+	elem, ok := c.cached(key)
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	if e := elem.Value.(*entry[K, V]); e.owner == c.am {
+		c.am.MoveToFront(elem)
+	}
+	return elem.Value.(*entry[K, V]).value, true
+}
+
+// Peek returns the value stored for key without side effects.
+func (c *TwoQ[K, V]) Peek(key K) (V, bool) {
+	if elem, ok := c.cached(key); ok {
+		return elem.Value.(*entry[K, V]).value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Put stores value for key. Keys remembered in A1out go straight to Am.
+func (c *TwoQ[K, V]) Put(key K, value V) {
+	elem, exists := c.items[key]
+	if exists {
+		e := elem.Value.(*entry[K, V])
+		switch e.owner {
+		case c.am:
+			e.value = value
+			c.am.MoveToFront(elem)
+		case c.a1in:
+			e.value = value
+		case c.a1out:
+			c.a1out.Remove(elem)
+			c.reclaim()
+			e.value = value
+			e.owner = c.am
+			c.items[key] = c.am.PushFront(e)
+		}
+		return
+	}
+	c.reclaim()
+	c.items[key] = c.a1in.PushFront(&entry[K, V]{key: key, value: value, owner: c.a1in})
+}
+
+// Remove deletes key, including any ghost history, and reports whether
+// it was cached.
+func (c *TwoQ[K, V]) Remove(key K) bool {
+	elem, exists := c.items[key]
+	if !exists {
+		return false
+	}
+	e := elem.Value.(*entry[K, V])
+	e.owner.Remove(elem)
+	delete(c.items, key)
+	return e.owner != c.a1out
+}
+
+// Contains checks if key is cached. Ghost entries do not count.
+func (c *TwoQ[K, V]) Contains(key K) bool {
+	_, ok := c.cached(key)
+	return ok
+}
+
+// Size returns the number of cached entries.
+func (c *TwoQ[K, V]) Size() int {
+	return c.a1in.Len() + c.am.Len()
+}
+
+// Capacity returns the maximum number of cached entries.
+func (c *TwoQ[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Clear removes all entries and ghost history.
+func (c *TwoQ[K, V]) Clear() {
+	c.a1in.Init()
+	c.a1out.Init()
+	c.am.Init()
+	c.items = make(map[K]*list.Element)
+}
+
+// Stats returns a snapshot of the cache statistics.
+func (c *TwoQ[K, V]) Stats() cache.Stats {
+	return c.stats
+}
+
+// cached returns the element for key if it holds a value.
+func (c *TwoQ[K, V]) cached(key K) (*list.Element, bool) {
+	elem, exists := c.items[key]
+	if !exists {
+		return nil, false
+	}
+	return elem, elem.Value.(*entry[K, V]).owner != c.a1out
+}
+
+// reclaim frees one slot if the cache is full. A1in gives up its oldest
+// entry when over target, remembering the key in A1out; otherwise the
+// least recently used entry of Am is dropped.
+func (c *TwoQ[K, V]) reclaim() {
+	if c.a1in.Len()+c.am.Len() < c.capacity {
+		return
+	}
+	if c.a1in.Len() > c.inSize || c.am.Len() == 0 {
+		elem := c.a1in.Back()
+		e := elem.Value.(*entry[K, V])
+		c.evicted(e)
+		c.a1in.Remove(elem)
+		var zero V
+		e.value = zero
+		e.owner = c.a1out
+		c.items[e.key] = c.a1out.PushFront(e)
+		if c.a1out.Len() > c.outSize {
+			ghost := c.a1out.Remove(c.a1out.Back()).(*entry[K, V])
+			delete(c.items, ghost.key)
+		}
+		return
+	}
+	e := c.am.Remove(c.am.Back()).(*entry[K, V])
+	delete(c.items, e.key)
+	c.evicted(e)
+}
+
+// evicted records the eviction of e and notifies the callback.
+func (c *TwoQ[K, V]) evicted(e *entry[K, V]) {
+	c.stats.Evictions++
+	if c.onEvict != nil {
+		c.onEvict(e.key, e.value)
+	}
+}
@@ -0,0 +1,59 @@
+package twoq_test
+
+import (
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/cache/twoq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTwoQ_PromotesRememberedKeys(t *testing.T) {
+	c := twoq.New[int, int](4, nil)
+
+	// Fill the cache, pushing 1 out of A1in into the ghost queue
+	for key := 1; key <= 5; key++ {
+		c.Put(key, key)
+	}
+	assert.False(t, c.Contains(1))
+
+	// A second request for 1 admits it to Am
+	c.Put(1, 1)
+	assert.True(t, c.Contains(1))
+
+	// Scans only churn A1in, so 1 survives them
+	for key := 100; key < 120; key++ {
+		c.Put(key, key)
+	}
+	assert.True(t, c.Contains(1))
+	assert.Equal(t, 4, c.Size())
+}
+
+func TestTwoQ_BasicOperations(t *testing.T) {
+	var evicted []int
+	var c cache.Cache[int, int] = twoq.NewWithRatios[int, int](2, 0.5, 1, func(key, _ int) {
+		evicted = append(evicted, key)
+	})
+
+	c.Put(1, 1)
+	c.Put(1, 10)
+	value, ok := c.Get(1)
+	assert.True(t, ok)
+	assert.Equal(t, 10, value)
+	_, ok = c.Get(2)
+	assert.False(t, ok)
+	_, ok = c.Peek(1)
+	assert.True(t, ok)
+	assert.Equal(t, cache.Stats{Hits: 1, Misses: 1}, c.Stats())
+
+	c.Put(2, 2)
+	c.Put(3, 3)
+	assert.Equal(t, []int{1}, evicted)
+	assert.Equal(t, 2, c.Size())
+
+	assert.True(t, c.Remove(2))
+	assert.False(t, c.Remove(1))
+	c.Clear()
+	assert.Equal(t, 0, c.Size())
+	assert.Equal(t, 2, c.Capacity())
+}
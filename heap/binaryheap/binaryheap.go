@@ -0,0 +1,186 @@
+package binaryheap
+
+import (
+	"cmp"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/heap"
+)
+
+// item is an element of the heap and the handle returned for it.
+type item[T any] struct {
+	value T
+	index int
+}
+
+func (it *item[T]) Value() T {
+	return it.value
+}
+
+// Heap is a binary min-heap stored in a slice. It is the usual default:
+// compact, cache friendly and O(log n) for every operation but Peek.
+type Heap[T any] struct {
+	items   []*item[T]
+	compare func(a, b T) int
+}
+
+// New creates an empty binary heap ordered by compare.
+func New[T any](compare func(a, b T) int) *Heap[T] {
+	return &Heap[T]{compare: compare}
+}
+
+// NewOrdered creates an empty binary heap using the natural order of T.
+func NewOrdered[T cmp.Ordered]() *Heap[T] {
+	return New[T](cmp.Compare[T])
+}
+
+// FromSlice creates a binary heap holding values, built in O(n).
+func FromSlice[T any](values []T, compare func(a, b T) int) *Heap[T] {
+	h := New(compare)
+	h.items = make([]*item[T], len(values))
+	for i, value := range values {
+		h.items[i] = &item[T]{value: value, index: i}
+	}
+	h.heapify()
+	return h
+}
+
+// Push inserts value and returns a handle to it.
+func (h *Heap[T]) Push(value T) heap.Handle[T] {
+	it := &item[T]{value: value, index: len(h.items)}
+	h.items = append(h.items, it)
+	h.up(it.index)
+	return it
+}
+
+// Pop removes and returns the smallest element.
+func (h *Heap[T]) Pop() (T, bool) {
+	if len(h.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	top := h.items[0]
+	last := len(h.items) - 1
+	h.swap(0, last)
+	h.items[last] = nil
+	h.items = h.items[:last]
+	if last > 0 {
+		h.down(0)
+	}
+	top.index = -1
+	return top.value, true
+}
+
+// Peek returns the smallest element without removing it.
+func (h *Heap[T]) Peek() (T, bool) {
+	if len(h.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.items[0].value, true
+}
+
+// DecreaseKey lowers the value of the element behind handle.
+func (h *Heap[T]) DecreaseKey(handle heap.Handle[T], value T) error {
+	it, ok := handle.(*item[T])
+	if !ok || it.index < 0 || it.index >= len(h.items) || h.items[it.index] != it {
+		return heap.ErrInvalidHandle
+	}
+	if h.compare(value, it.value) > 0 {
+		return heap.ErrKeyIncreased
+	}
+	it.value = value
+	h.up(it.index)
+	return nil
+}
+
+// Merge moves every element of other into h in O(n + m).
+func (h *Heap[T]) Merge(other heap.PriorityQueue[T]) {
+	o, ok := other.(*Heap[T])
+	if !ok {
+		for !other.IsEmpty() {
+			value, _ := other.Pop()
+			h.Push(value)
+		}
+		return
+	}
+	if o == h || len(o.items) == 0 {
+		return
+	}
+	for _, it := range o.items {
+		it.index = len(h.items)
+		h.items = append(h.items, it)
+	}
+	o.items = nil
+	h.heapify()
+}
+
+// Len returns the number of elements in the heap.
+func (h *Heap[T]) Len() int {
+	return len(h.items)
+}
+
+// IsEmpty checks if the heap is empty.
+func (h *Heap[T]) IsEmpty() bool {
+	return len(h.items) == 0
+}
+
+// Clear removes all elements from the heap.
+func (h *Heap[T]) Clear() {
+	for _, it := range h.items {
+		it.index = -1
+	}
+	h.items = nil
+}
+
+// Values returns the elements in no particular order.
+func (h *Heap[T]) Values() []T {
+	values := make([]T, len(h.items))
+	for i, it := range h.items {
+		values[i] = it.value
+	}
+	return values
+}
+
+// heapify restores the heap property over the whole slice.
+func (h *Heap[T]) heapify() {
+	for i := len(h.items)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+}
+
+// up moves the item at i towards the root until its parent is smaller.
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if h.compare(h.items[i].value, h.items[parent].value) >= 0 {
+			return
+		}
+		h.swap(i, parent)
+		i = parent
+	}
+}
+
+// down moves the item at i towards the leaves until its children are larger.
+func (h *Heap[T]) down(i int) {
+	n := len(h.items)
+	for {
+		smallest := 2*i + 1
+		if smallest >= n {
+			return
+		}
+		if right := smallest + 1; right < n && h.compare(h.items[right].value, h.items[smallest].value) < 0 {
+			smallest = right
+		}
+		if h.compare(h.items[smallest].value, h.items[i].value) >= 0 {
+			return
+		}
+		h.swap(i, smallest)
+		i = smallest
+	}
+}
+
+func (h *Heap[T]) swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index = i
+	h.items[j].index = j
+}
@@ -0,0 +1,23 @@
+package binaryheap_test
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/heap/binaryheap"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromSlice(t *testing.T) {
+	values := []int{5, 3, 8, 1, 9, 2}
+	h := binaryheap.FromSlice(values, cmp.Compare[int])
+	assert.Equal(t, 6, h.Len())
+	assert.Equal(t, []int{5, 3, 8, 1, 9, 2}, values)
+
+	var popped []int
+	for !h.IsEmpty() {
+		value, _ := h.Pop()
+		popped = append(popped, value)
+	}
+	assert.Equal(t, []int{1, 2, 3, 5, 8, 9}, popped)
+}
@@ -0,0 +1,185 @@
+package daryheap
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/heap"
+)
+
+// item is an element of the heap and the handle returned for it.
+type item[T any] struct {
+	value T
+	index int
+}
+
+func (it *item[T]) Value() T {
+	return it.value
+}
+
+// Heap is a d-ary min-heap stored in a slice. A larger d makes the tree
+// shallower, so Push and DecreaseKey get cheaper while Pop compares more
+// children per level.
+type Heap[T any] struct {
+	items   []*item[T]
+	d       int
+	compare func(a, b T) int
+}
+
+// New creates an empty d-ary heap ordered by compare.
+// It panics if d is less than 2.
+func New[T any](d int, compare func(a, b T) int) *Heap[T] {
+	if d < 2 {
+		panic(fmt.Sprintf("daryheap: invalid arity %d", d))
+	}
+	return &Heap[T]{d: d, compare: compare}
+}
+
+// NewOrdered creates an empty d-ary heap using the natural order of T.
+func NewOrdered[T cmp.Ordered](d int) *Heap[T] {
+	return New[T](d, cmp.Compare[T])
+}
+
+// Arity returns the number of children per node.
+func (h *Heap[T]) Arity() int {
+	return h.d
+}
+
+// Push inserts value and returns a handle to it.
+func (h *Heap[T]) Push(value T) heap.Handle[T] {
+	it := &item[T]{value: value, index: len(h.items)}
+	h.items = append(h.items, it)
+	h.up(it.index)
+	return it
+}
+
+// Pop removes and returns the smallest element.
+func (h *Heap[T]) Pop() (T, bool) {
+	if len(h.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	top := h.items[0]
+	last := len(h.items) - 1
+	h.swap(0, last)
+	h.items[last] = nil
+	h.items = h.items[:last]
+	if last > 0 {
+		h.down(0)
+	}
+	top.index = -1
+	return top.value, true
+}
+
+// Peek returns the smallest element without removing it.
+func (h *Heap[T]) Peek() (T, bool) {
+	if len(h.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.items[0].value, true
+}
+
+// DecreaseKey lowers the value of the element behind handle.
+func (h *Heap[T]) DecreaseKey(handle heap.Handle[T], value T) error {
+	it, ok := handle.(*item[T])
+	if !ok || it.index < 0 || it.index >= len(h.items) || h.items[it.index] != it {
+		return heap.ErrInvalidHandle
+	}
+	if h.compare(value, it.value) > 0 {
+		return heap.ErrKeyIncreased
+	}
+	it.value = value
+	h.up(it.index)
+	return nil
+}
+
+// Merge moves every element of other into h in O(n + m).
+func (h *Heap[T]) Merge(other heap.PriorityQueue[T]) {
+	o, ok := other.(*Heap[T])
+	if !ok {
+		for !other.IsEmpty() {
+			value, _ := other.Pop()
+			h.Push(value)
+		}
+		return
+	}
+	if o == h || len(o.items) == 0 {
+		return
+	}
+	for _, it := range o.items {
+		it.index = len(h.items)
+		h.items = append(h.items, it)
+	}
+	o.items = nil
+	for i := (len(h.items) - 2) / h.d; i >= 0; i-- {
+		h.down(i)
+	}
+}
+
+// Len returns the number of elements in the heap.
+func (h *Heap[T]) Len() int {
+	return len(h.items)
+}
+
+// IsEmpty checks if the heap is empty.
+func (h *Heap[T]) IsEmpty() bool {
+	return len(h.items) == 0
+}
+
+// Clear removes all elements from the heap.
+func (h *Heap[T]) Clear() {
+	for _, it := range h.items {
+		it.index = -1
+	}
+	h.items = nil
+}
+
+// Values returns the elements in no particular order.
+func (h *Heap[T]) Values() []T {
+	values := make([]T, len(h.items))
+	for i, it := range h.items {
+		values[i] = it.value
+	}
+	return values
+}
+
+// up moves the item at i towards the root until its parent is smaller.
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / h.d
+		if h.compare(h.items[i].value, h.items[parent].value) >= 0 {
+			return
+		}
+		h.swap(i, parent)
+		i = parent
+	}
+}
+
+// down moves the item at i towards the leaves until its children are larger.
+func (h *Heap[T]) down(i int) {
+	n := len(h.items)
+	for {
+		first := i*h.d + 1
+		if first >= n {
+			return
+		}
+		smallest := first
+		for c := first + 1; c < min(first+h.d, n); c++ {
+			if h.compare(h.items[c].value, h.items[smallest].value) < 0 {
+				smallest = c
+			}
+		}
+		if h.compare(h.items[smallest].value, h.items[i].value) >= 0 {
+			return
+		}
+		h.swap(i, smallest)
+		i = smallest
+	}
+}
+
+func (h *Heap[T]) swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index = i
+	h.items[j].index = j
+}
@@ -0,0 +1,24 @@
+package daryheap_test
+
+import (
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/heap/daryheap"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew_InvalidArity(t *testing.T) {
+	assert.PanicsWithValue(t, "daryheap: invalid arity 1", func() { daryheap.NewOrdered[int](1) })
+}
+
+func TestHeap_Arity(t *testing.T) {
+	h := daryheap.NewOrdered[int](5)
+	assert.Equal(t, 5, h.Arity())
+	for _, v := range []int{9, 4, 7, 1, 8, 2, 6, 3, 5} {
+		h.Push(v)
+	}
+	for want := 1; want <= 9; want++ {
+		got, _ := h.Pop()
+		assert.Equal(t, want, got)
+	}
+}
@@ -0,0 +1,290 @@
+package fibheap
+
+import (
+	"cmp"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/heap"
+)
+
+// node is an element of the heap and the handle returned for it. Siblings
+// form a circular doubly linked list.
+type node[T any] struct {
+	value   T
+	parent  *node[T]
+	child   *node[T]
+	left    *node[T]
+	right   *node[T]
+	degree  int
+	mark    bool
+	removed bool
+}
+
+func (n *node[T]) Value() T {
+	return n.value
+}
+
+// Heap is a Fibonacci heap. Push, Merge and DecreaseKey are O(1)
+// amortized and Pop is O(log n) amortized, the best bounds among the
+// heaps here, at the price of larger constants.
+type Heap[T any] struct {
+	min     *node[T]
+	size    int
+	compare func(a, b T) int
+	degrees []*node[T]
+	roots   []*node[T]
+}
+
+// New creates an empty Fibonacci heap ordered by compare.
+func New[T any](compare func(a, b T) int) *Heap[T] {
+	return &Heap[T]{compare: compare}
+}
+
+// NewOrdered creates an empty Fibonacci heap using the natural order of T.
+func NewOrdered[T cmp.Ordered]() *Heap[T] {
+	return New[T](cmp.Compare[T])
+}
+
+// Push inserts value and returns a handle to it.
+func (h *Heap[T]) Push(value T) heap.Handle[T] {
+	n := &node[T]{value: value}
+	n.left, n.right = n, n
+	h.addRoot(n)
+	h.size++
+	return n
+}
+
+// Pop removes and returns the smallest element.
+func (h *Heap[T]) Pop() (T, bool) {
+	z := h.min
+	if z == nil {
+		var zero T
+		return zero, false
+	}
+	for z.child != nil {
+		c := z.child
+		if c.right == c {
+			z.child = nil
+		} else {
+			z.child = c.right
+			unlink(c)
+		}
+		c.parent = nil
+		c.left, c.right = c, c
+		insertAfter(z, c)
+	}
+	if z.right == z {
+		h.min = nil
+	} else {
+		h.min = z.right
+		unlink(z)
+		h.consolidate()
+	}
+	h.size--
+	z.left, z.right, z.removed = nil, nil, true
+	return z.value, true
+}
+
+// Peek returns the smallest element without removing it.
+func (h *Heap[T]) Peek() (T, bool) {
+	if h.min == nil {
+		var zero T
+		return zero, false
+	}
+	return h.min.value, true
+}
+
+// DecreaseKey lowers the value of the element behind handle, cutting it
+// from its parent if the heap order is violated.
+func (h *Heap[T]) DecreaseKey(handle heap.Handle[T], value T) error {
+	n, ok := handle.(*node[T])
+	if !ok || n.removed {
+		return heap.ErrInvalidHandle
+	}
+	if h.compare(value, n.value) > 0 {
+		return heap.ErrKeyIncreased
+	}
+	n.value = value
+	if p := n.parent; p != nil && h.compare(n.value, p.value) < 0 {
+		h.cut(n, p)
+		h.cascadingCut(p)
+	}
+	if h.compare(n.value, h.min.value) < 0 {
+		h.min = n
+	}
+	return nil
+}
+
+// Merge moves every element of other into h. Merging two Fibonacci heaps
+// is O(1).
+func (h *Heap[T]) Merge(other heap.PriorityQueue[T]) {
+	o, ok := other.(*Heap[T])
+	if !ok {
+		for !other.IsEmpty() {
+			value, _ := other.Pop()
+			h.Push(value)
+		}
+		return
+	}
+	if o == h || o.min == nil {
+		return
+	}
+	if h.min == nil {
+		h.min = o.min
+	} else {
+		a, b := h.min.right, o.min.left
+		h.min.right, o.min.left = o.min, h.min
+		a.left, b.right = b, a
+		if h.compare(o.min.value, h.min.value) < 0 {
+			h.min = o.min
+		}
+	}
+	h.size += o.size
+	o.min, o.size = nil, 0
+}
+
+// Len returns the number of elements in the heap.
+func (h *Heap[T]) Len() int {
+	return h.size
+}
+
+// IsEmpty checks if the heap is empty.
+func (h *Heap[T]) IsEmpty() bool {
+	return h.size == 0
+}
+
+// Clear removes all elements from the heap.
+func (h *Heap[T]) Clear() {
+	each(h.min, func(n *node[T]) { n.removed = true })
+	h.min, h.size = nil, 0
+}
+
+// Values returns the elements in no particular order.
+func (h *Heap[T]) Values() []T {
+	values := make([]T, 0, h.size)
+	each(h.min, func(n *node[T]) { values = append(values, n.value) })
+	return values
+}
+
+// addRoot adds a single node to the root list.
+func (h *Heap[T]) addRoot(n *node[T]) {
+	if h.min == nil {
+		h.min = n
+		return
+	}
+	insertAfter(h.min, n)
+	if h.compare(n.value, h.min.value) < 0 {
+		h.min = n
+	}
+}
+
+// consolidate links roots of equal degree until every root has a
+// distinct degree, then finds the new minimum.
+func (h *Heap[T]) consolidate() {
+	roots := h.roots[:0]
+	for n := h.min; ; {
+		roots = append(roots, n)
+		if n = n.right; n == h.min {
+			break
+		}
+	}
+
+	degrees := h.degrees[:0]
+	for _, x := range roots {
+		d := x.degree
+		for d < len(degrees) && degrees[d] != nil {
+			y := degrees[d]
+			if h.compare(y.value, x.value) < 0 {
+				x, y = y, x
+			}
+			h.link(y, x)
+			degrees[d] = nil
+			d++
+		}
+		for len(degrees) <= d {
+			degrees = append(degrees, nil)
+		}
+		degrees[d] = x
+	}
+	clear(roots)
+	h.roots = roots
+
+	h.min = nil
+	for i, n := range degrees {
+		if n != nil && (h.min == nil || h.compare(n.value, h.min.value) < 0) {
+			h.min = n
+		}
+		degrees[i] = nil
+	}
+	h.degrees = degrees
+}
+
+// link makes the root y a child of the root x.
+func (h *Heap[T]) link(y, x *node[T]) {
+	unlink(y)
+	y.left, y.right = y, y
+	y.parent = x
+	if x.child == nil {
+		x.child = y
+	} else {
+		insertAfter(x.child, y)
+	}
+	x.degree++
+	y.mark = false
+}
+
+// cut moves n from the children of p to the root list.
+func (h *Heap[T]) cut(n, p *node[T]) {
+	if n.right == n {
+		p.child = nil
+	} else {
+		if p.child == n {
+			p.child = n.right
+		}
+		unlink(n)
+	}
+	p.degree--
+	n.left, n.right = n, n
+	n.parent = nil
+	n.mark = false
+	insertAfter(h.min, n)
+}
+
+// cascadingCut cuts n from its parent if it already lost a child,
+// and continues upwards.
+func (h *Heap[T]) cascadingCut(n *node[T]) {
+	for p := n.parent; p != nil; n, p = p, p.parent {
+		if !n.mark {
+			n.mark = true
+			return
+		}
+		h.cut(n, p)
+	}
+}
+
+// insertAfter places the single node n right of a in a's circular list.
+func insertAfter[T any](a, n *node[T]) {
+	n.left, n.right = a, a.right
+	a.right.left = n
+	a.right = n
+}
+
+// unlink removes n from its circular list without touching n's pointers.
+func unlink[T any](n *node[T]) {
+	n.left.right = n.right
+	n.right.left = n.left
+}
+
+// each calls fn for every node reachable from the circular list at start.
+func each[T any](start *node[T], fn func(n *node[T])) {
+	if start == nil {
+		return
+	}
+	n := start
+	for {
+		fn(n)
+		each(n.child, fn)
+		if n = n.right; n == start {
+			return
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package fibheap_test
+
+import (
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/heap"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/heap/fibheap"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeap_CascadingCuts(t *testing.T) {
+	h := fibheap.NewOrdered[int]()
+	handles := make([]heap.Handle[int], 64)
+	for i := range handles {
+		handles[i] = h.Push(100 + i)
+	}
+	// One pop consolidates the rest into deep trees
+	h.Pop()
+
+	// Decreasing leaves repeatedly marks and cuts their ancestors
+	for i := len(handles) - 1; i > 0; i -= 2 {
+		assert.NoError(t, h.DecreaseKey(handles[i], i))
+	}
+	assert.Equal(t, 63, h.Len())
+
+	previous := -1
+	for !h.IsEmpty() {
+		value, _ := h.Pop()
+		assert.Greater(t, value, previous)
+		previous = value
+	}
+}
@@ -0,0 +1,56 @@
+package heap
+
+import "errors"
+
+var (
+	// ErrInvalidHandle is returned when a handle no longer refers to an
+	// element of the heap, for example because it was popped.
+	ErrInvalidHandle = errors.New("heap: handle is not in the heap")
+
+	// ErrKeyIncreased is returned by DecreaseKey when the new value orders
+	// after the current one.
+	ErrKeyIncreased = errors.New("heap: new value is greater than the current value")
+)
+
+// Handle refers to an element pushed into a PriorityQueue. It stays valid
+// until the element is popped and is used to change its priority.
+type Handle[T any] interface {
+	// Value returns the current value of the element.
+	Value() T
+}
+
+// PriorityQueue defines the operations of a min-priority queue ordered by
+// a comparator. Use a reversed comparator for a max-priority queue.
+type PriorityQueue[T any] interface {
+	// Push inserts value and returns a handle to it.
+	Push(value T) Handle[T]
+
+	// Pop removes and returns the smallest element.
+	Pop() (T, bool)
+
+	// Peek returns the smallest element without removing it.
+	Peek() (T, bool)
+
+	// DecreaseKey replaces the value of the element behind h with value,
+	// which must not order after the current value. Handles from another
+	// heap must not be passed.
+	DecreaseKey(h Handle[T], value T) error
+
+	// Merge moves every element of other into the queue, leaving other
+	// empty. Both queues must use the same ordering. When other has the
+	// same implementation its handles stay valid; otherwise its elements
+	// are popped and pushed again and its handles are invalidated.
+	Merge(other PriorityQueue[T])
+
+	// Len returns the number of elements in the queue.
+	Len() int
+
+	// IsEmpty checks if the queue is empty.
+	IsEmpty() bool
+
+	// Clear removes all elements from the queue.
+	Clear()
+
+	// Values returns the elements in no particular order.
+	Values() []T
+}
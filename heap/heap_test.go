@@ -0,0 +1,228 @@
+package heap_test
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/heap"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/heap/binaryheap"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/heap/daryheap"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/heap/fibheap"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/heap/pairingheap"
+	"github.com/stretchr/testify/assert"
+)
+
+// heaps lists every priority queue implementation under comparison.
+var heaps = []struct {
+	name string
+	new  func() heap.PriorityQueue[int]
+}{
+	{"Binary", func() heap.PriorityQueue[int] { return binaryheap.NewOrdered[int]() }},
+	{"4-ary", func() heap.PriorityQueue[int] { return daryheap.NewOrdered[int](4) }},
+	{"8-ary", func() heap.PriorityQueue[int] { return daryheap.NewOrdered[int](8) }},
+	{"Pairing", func() heap.PriorityQueue[int] { return pairingheap.NewOrdered[int]() }},
+	{"Fibonacci", func() heap.PriorityQueue[int] { return fibheap.NewOrdered[int]() }},
+}
+
+// drain pops every element of pq.
+func drain(pq heap.PriorityQueue[int]) []int {
+	var values []int
+	for !pq.IsEmpty() {
+		value, _ := pq.Pop()
+		values = append(values, value)
+	}
+	return values
+}
+
+func TestPriorityQueue_PopsInOrder(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, impl := range heaps {
+		pq := impl.new()
+		_, ok := pq.Pop()
+		assert.False(t, ok, impl.name)
+
+		var expected []int
+		for i := 0; i < 500; i++ {
+			value := rng.Intn(100)
+			pq.Push(value)
+			expected = append(expected, value)
+			// Interleave pops to exercise consolidation and restructuring
+			if i%7 == 0 {
+				slices.Sort(expected)
+				got, _ := pq.Pop()
+				assert.Equal(t, expected[0], got, impl.name)
+				expected = expected[1:]
+			}
+		}
+		slices.Sort(expected)
+		top, _ := pq.Peek()
+		assert.Equal(t, expected[0], top, impl.name)
+		assert.Equal(t, len(expected), pq.Len(), impl.name)
+
+		values := pq.Values()
+		slices.Sort(values)
+		assert.Equal(t, expected, values, impl.name)
+		assert.Equal(t, expected, drain(pq), impl.name)
+	}
+}
+
+func TestPriorityQueue_DecreaseKey(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for _, impl := range heaps {
+		pq := impl.new()
+		handles := make([]heap.Handle[int], 300)
+		for i := range handles {
+			handles[i] = pq.Push(1000 + rng.Intn(1000))
+		}
+		// Pop a few first so the tree-based heaps have structure to cut
+		popped := map[int]bool{}
+		for i := 0; i < 20; i++ {
+			value, _ := pq.Pop()
+			for j, h := range handles {
+				if !popped[j] && h.Value() == value {
+					popped[j] = true
+					break
+				}
+			}
+		}
+
+		var expected []int
+		for i, h := range handles {
+			if popped[i] {
+				assert.ErrorIs(t, pq.DecreaseKey(h, 0), heap.ErrInvalidHandle, impl.name)
+				continue
+			}
+			value := h.Value()
+			if rng.Intn(2) == 0 {
+				value -= rng.Intn(1500)
+				assert.NoError(t, pq.DecreaseKey(h, value), impl.name)
+				assert.Equal(t, value, h.Value(), impl.name)
+			}
+			expected = append(expected, value)
+		}
+		assert.ErrorIs(t, pq.DecreaseKey(handles[len(handles)-1], 1<<30), heap.ErrKeyIncreased, impl.name)
+
+		slices.Sort(expected)
+		assert.Equal(t, expected, drain(pq), impl.name)
+	}
+}
+
+func TestPriorityQueue_Merge(t *testing.T) {
+	for _, impl := range heaps {
+		for _, peer := range heaps {
+			a, b := impl.new(), peer.new()
+			for i := 0; i < 10; i++ {
+				a.Push(i * 2)
+				b.Push(i*2 + 1)
+			}
+			h := b.Push(100)
+
+			a.Merge(b)
+			a.Merge(a)
+			assert.Equal(t, 21, a.Len(), impl.name+"+"+peer.name)
+			assert.True(t, b.IsEmpty(), impl.name+"+"+peer.name)
+
+			if impl.name == peer.name {
+				// Handles survive merging heaps of the same kind
+				assert.NoError(t, a.DecreaseKey(h, -1), impl.name)
+				top, _ := a.Peek()
+				assert.Equal(t, -1, top, impl.name)
+				a.Pop()
+			} else {
+				a.Pop()
+			}
+
+			values := drain(a)
+			assert.True(t, slices.IsSorted(values), impl.name+"+"+peer.name)
+			assert.Len(t, values, 20, impl.name+"+"+peer.name)
+		}
+	}
+}
+
+func TestPriorityQueue_ClearInvalidatesHandles(t *testing.T) {
+	for _, impl := range heaps {
+		pq := impl.new()
+		h := pq.Push(5)
+		pq.Push(3)
+		pq.Clear()
+		assert.True(t, pq.IsEmpty(), impl.name)
+		assert.ErrorIs(t, pq.DecreaseKey(h, 1), heap.ErrInvalidHandle, impl.name)
+
+		pq.Push(7)
+		top, _ := pq.Peek()
+		assert.Equal(t, 7, top, impl.name)
+	}
+}
+
+func TestPriorityQueue_CustomComparator(t *testing.T) {
+	type task struct {
+		name     string
+		priority int
+	}
+	byPriorityDesc := func(a, b task) int { return cmp.Compare(b.priority, a.priority) }
+	queues := []heap.PriorityQueue[task]{
+		binaryheap.New(byPriorityDesc),
+		daryheap.New(3, byPriorityDesc),
+		pairingheap.New(byPriorityDesc),
+		fibheap.New(byPriorityDesc),
+	}
+	for _, pq := range queues {
+		pq.Push(task{"low", 1})
+		pq.Push(task{"high", 9})
+		pq.Push(task{"mid", 5})
+		first, _ := pq.Pop()
+		second, _ := pq.Pop()
+		assert.Equal(t, "high", first.name)
+		assert.Equal(t, "mid", second.name)
+	}
+}
+
+// BenchmarkPriorityQueue_PushPop measures heap sort style usage.
+func BenchmarkPriorityQueue_PushPop(b *testing.B) {
+	values := rand.New(rand.NewSource(3)).Perm(10000)
+	for _, impl := range heaps {
+		b.Run(impl.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				pq := impl.new()
+				for _, value := range values {
+					pq.Push(value)
+				}
+				for !pq.IsEmpty() {
+					pq.Pop()
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkPriorityQueue_DecreaseKey mimics Dijkstra's algorithm: many
+// decrease-key operations interleaved with fewer pops.
+func BenchmarkPriorityQueue_DecreaseKey(b *testing.B) {
+	const n = 10000
+	rng := rand.New(rand.NewSource(4))
+	targets := make([]int, 8*n)
+	for i := range targets {
+		targets[i] = rng.Intn(n)
+	}
+	for _, impl := range heaps {
+		b.Run(impl.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				pq := impl.new()
+				handles := make([]heap.Handle[int], n)
+				for j := range handles {
+					handles[j] = pq.Push(1 << 30)
+				}
+				for j, target := range targets {
+					if h := handles[target]; h.Value() > 0 {
+						_ = pq.DecreaseKey(h, h.Value()-1-j%7)
+					}
+					if j%8 == 7 {
+						pq.Pop()
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package indexedheap
+
+import (
+	"cmp"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set"
+)
+
+// entry is an element together with its priority.
+type entry[T set.Setable, P any] struct {
+	value    T
+	priority P
+}
+
+// IndexedHeap is a binary heap of elements ordered by a separate priority.
+// Elements are identified by their Hash, as in HashSet, so that the
+// priority of an element can be changed or the element removed without a
+// handle. Push, Pop, Update and Remove are O(log n); Contains and
+// Priority are O(1).
+type IndexedHeap[T set.Setable, P any] struct {
+	entries []entry[T, P]
+	index   map[string]int
+	compare func(a, b P) int
+}
+
+// New creates an empty IndexedHeap that pops the element whose priority
+// orders first under compare.
+func New[T set.Setable, P any](compare func(a, b P) int) *IndexedHeap[T, P] {
+	return &IndexedHeap[T, P]{
+		index:   make(map[string]int),
+		compare: compare,
+	}
+}
+
+// NewMin creates an empty IndexedHeap that pops the lowest priority first.
+func NewMin[T set.Setable, P cmp.Ordered]() *IndexedHeap[T, P] {
+	return New[T](cmp.Compare[P])
+}
+
+// NewMax creates an empty IndexedHeap that pops the highest priority first.
+func NewMax[T set.Setable, P cmp.Ordered]() *IndexedHeap[T, P] {
+	return New[T](func(a, b P) int { return cmp.Compare(b, a) })
+}
+
+// Push inserts value with priority. It returns false, leaving the heap
+// unchanged, if an element with the same Hash is already present.
+func (h *IndexedHeap[T, P]) Push(value T, priority P) bool {
+	key := value.Hash()
+	if _, exists := h.index[key]; exists {
+		return false
+	}
+	h.entries = append(h.entries, entry[T, P]{value: value, priority: priority})
+	h.index[key] = len(h.entries) - 1
+	h.up(len(h.entries) - 1)
+	return true
+}
+
+// Update changes the priority of value, moving it up or down as needed.
+// It returns false if value is not in the heap.
+func (h *IndexedHeap[T, P]) Update(value T, priority P) bool {
+	i, exists := h.index[value.Hash()]
+	if !exists {
+		return false
+	}
+	h.entries[i].priority = priority
+	h.fix(i)
+	return true
+}
+
+// PushOrUpdate inserts value with priority, or changes its priority if
+// it is already present.
+func (h *IndexedHeap[T, P]) PushOrUpdate(value T, priority P) {
+	if !h.Update(value, priority) {
+		h.Push(value, priority)
+	}
+}
+
+// Pop removes and returns the element with the first priority.
+func (h *IndexedHeap[T, P]) Pop() (T, P, bool) {
+	if len(h.entries) == 0 {
+		var value T
+		var priority P
+		return value, priority, false
+	}
+	top := h.entries[0]
+	h.removeAt(0)
+	return top.value, top.priority, true
+}
+
+// Peek returns the element with the first priority without removing it.
+func (h *IndexedHeap[T, P]) Peek() (T, P, bool) {
+	if len(h.entries) == 0 {
+		var value T
+		var priority P
+		return value, priority, false
+	}
+	return h.entries[0].value, h.entries[0].priority, true
+}
+
+// Remove deletes value from the heap and reports whether it was present.
+func (h *IndexedHeap[T, P]) Remove(value T) bool {
+	i, exists := h.index[value.Hash()]
+	if !exists {
+		return false
+	}
+	h.removeAt(i)
+	return true
+}
+
+// Contains checks if value is in the heap.
+func (h *IndexedHeap[T, P]) Contains(value T) bool {
+	_, exists := h.index[value.Hash()]
+	return exists
+}
+
+// Priority returns the priority of value and whether it is in the heap.
+func (h *IndexedHeap[T, P]) Priority(value T) (P, bool) {
+	i, exists := h.index[value.Hash()]
+	if !exists {
+		var priority P
+		return priority, false
+	}
+	return h.entries[i].priority, true
+}
+
+// Len returns the number of elements in the heap.
+func (h *IndexedHeap[T, P]) Len() int {
+	return len(h.entries)
+}
+
+// IsEmpty checks if the heap is empty.
+func (h *IndexedHeap[T, P]) IsEmpty() bool {
+	return len(h.entries) == 0
+}
+
+// Clear removes all elements from the heap.
+func (h *IndexedHeap[T, P]) Clear() {
+	h.entries = nil
+	clear(h.index)
+}
+
+// Values returns the elements in no particular order.
+func (h *IndexedHeap[T, P]) Values() []T {
+	values := make([]T, len(h.entries))
+	for i, e := range h.entries {
+		values[i] = e.value
+	}
+	return values
+}
+
+// removeAt deletes the entry at i and restores the heap.
+func (h *IndexedHeap[T, P]) removeAt(i int) {
+	last := len(h.entries) - 1
+	delete(h.index, h.entries[i].value.Hash())
+	if i != last {
+		h.entries[i] = h.entries[last]
+		h.index[h.entries[i].value.Hash()] = i
+	}
+	h.entries[last] = entry[T, P]{}
+	h.entries = h.entries[:last]
+	if i != last {
+		h.fix(i)
+	}
+}
+
+// fix moves the entry at i to its place after its priority changed.
+func (h *IndexedHeap[T, P]) fix(i int) {
+	if !h.up(i) {
+		h.down(i)
+	}
+}
+
+// up moves the entry at i towards the root and reports whether it moved.
+func (h *IndexedHeap[T, P]) up(i int) bool {
+	moved := false
+	for i > 0 {
+		parent := (i - 1) / 2
+		if h.compare(h.entries[i].priority, h.entries[parent].priority) >= 0 {
+			break
+		}
+		h.swap(i, parent)
+		i, moved = parent, true
+	}
+	return moved
+}
+
+// down moves the entry at i towards the leaves.
+func (h *IndexedHeap[T, P]) down(i int) {
+	n := len(h.entries)
+	for {
+		smallest := 2*i + 1
+		if smallest >= n {
+			return
+		}
+		if right := smallest + 1; right < n && h.compare(h.entries[right].priority, h.entries[smallest].priority) < 0 {
+			smallest = right
+		}
+		if h.compare(h.entries[smallest].priority, h.entries[i].priority) >= 0 {
+			return
+		}
+		h.swap(i, smallest)
+		i = smallest
+	}
+}
+
+func (h *IndexedHeap[T, P]) swap(i, j int) {
+	h.entries[i], h.entries[j] = h.entries[j], h.entries[i]
+	h.index[h.entries[i].value.Hash()] = i
+	h.index[h.entries[j].value.Hash()] = j
+}
@@ -0,0 +1,117 @@
+package indexedheap_test
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/heap/indexedheap"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func item(id string) *mocks.MockSetable {
+	return mocks.NewMockSetable(id)
+}
+
+func TestIndexedHeap_IdentifiesByHash(t *testing.T) {
+	h := indexedheap.NewMin[*mocks.MockSetable, int]()
+	assert.True(t, h.Push(item("a"), 5))
+	assert.True(t, h.Push(item("b"), 3))
+	assert.True(t, h.Push(item("c"), 8))
+
+	// A distinct instance with the same Hash is the same element
+	assert.False(t, h.Push(item("a"), 1))
+	assert.True(t, h.Contains(item("a")))
+	assert.True(t, h.Update(item("a"), 1))
+	assert.False(t, h.Update(item("z"), 1))
+	priority, ok := h.Priority(item("a"))
+	assert.True(t, ok)
+	assert.Equal(t, 1, priority)
+
+	value, priority, ok := h.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, "a", value.ID)
+	assert.Equal(t, 1, priority)
+
+	assert.True(t, h.Remove(item("a")))
+	assert.False(t, h.Remove(item("a")))
+	assert.False(t, h.Contains(item("a")))
+
+	h.PushOrUpdate(item("c"), 0)
+	h.PushOrUpdate(item("d"), 4)
+	var order []string
+	for !h.IsEmpty() {
+		value, _, _ := h.Pop()
+		order = append(order, value.ID)
+	}
+	assert.Equal(t, []string{"c", "b", "d"}, order)
+	_, _, ok = h.Pop()
+	assert.False(t, ok)
+}
+
+func TestIndexedHeap_Max(t *testing.T) {
+	h := indexedheap.NewMax[*mocks.MockSetable, float64]()
+	h.Push(item("low"), 0.1)
+	h.Push(item("high"), 0.9)
+	h.Push(item("mid"), 0.5)
+	h.Update(item("low"), 1.0)
+
+	value, priority, _ := h.Pop()
+	assert.Equal(t, "low", value.ID)
+	assert.Equal(t, 1.0, priority)
+	value, _, _ = h.Pop()
+	assert.Equal(t, "high", value.ID)
+	assert.Equal(t, 1, h.Len())
+
+	h.Clear()
+	assert.True(t, h.IsEmpty())
+	assert.False(t, h.Contains(item("mid")))
+}
+
+func TestIndexedHeap_RandomOperations(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	h := indexedheap.NewMin[*mocks.MockSetable, int]()
+	reference := map[string]int{}
+
+	for i := 0; i < 2000; i++ {
+		id := fmt.Sprintf("n%d", rng.Intn(100))
+		switch rng.Intn(4) {
+		case 0:
+			priority := rng.Intn(1000)
+			h.PushOrUpdate(item(id), priority)
+			reference[id] = priority
+		case 1:
+			_, exists := reference[id]
+			assert.Equal(t, exists, h.Remove(item(id)))
+			delete(reference, id)
+		case 2:
+			priority := rng.Intn(1000)
+			_, exists := reference[id]
+			assert.Equal(t, exists, h.Update(item(id), priority))
+			if exists {
+				reference[id] = priority
+			}
+		case 3:
+			if len(reference) == 0 {
+				continue
+			}
+			value, priority, _ := h.Pop()
+			for _, p := range reference {
+				assert.LessOrEqual(t, priority, p)
+			}
+			assert.Equal(t, reference[value.ID], priority)
+			delete(reference, value.ID)
+		}
+		assert.Equal(t, len(reference), h.Len())
+	}
+
+	var priorities []int
+	for !h.IsEmpty() {
+		_, priority, _ := h.Pop()
+		priorities = append(priorities, priority)
+	}
+	assert.True(t, sort.IntsAreSorted(priorities))
+	assert.Len(t, priorities, len(reference))
+}
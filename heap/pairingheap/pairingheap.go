@@ -0,0 +1,201 @@
+package pairingheap
+
+import (
+	"cmp"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/heap"
+)
+
+// node is an element of the heap and the handle returned for it. Children
+// are kept as a sibling list; prev points to the parent for the first
+// child and to the left sibling otherwise.
+type node[T any] struct {
+	value   T
+	child   *node[T]
+	sibling *node[T]
+	prev    *node[T]
+	removed bool
+}
+
+func (n *node[T]) Value() T {
+	return n.value
+}
+
+// Heap is a pairing heap. Push, Merge and DecreaseKey are O(1) and Pop is
+// O(log n) amortized, which makes it a fast choice for graph algorithms
+// that decrease keys often.
+type Heap[T any] struct {
+	root    *node[T]
+	size    int
+	compare func(a, b T) int
+	scratch []*node[T]
+}
+
+// New creates an empty pairing heap ordered by compare.
+func New[T any](compare func(a, b T) int) *Heap[T] {
+	return &Heap[T]{compare: compare}
+}
+
+// NewOrdered creates an empty pairing heap using the natural order of T.
+func NewOrdered[T cmp.Ordered]() *Heap[T] {
+	return New[T](cmp.Compare[T])
+}
+
+// Push inserts value and returns a handle to it.
+func (h *Heap[T]) Push(value T) heap.Handle[T] {
+	n := &node[T]{value: value}
+	h.root = h.meld(h.root, n)
+	h.size++
+	return n
+}
+
+// Pop removes and returns the smallest element.
+func (h *Heap[T]) Pop() (T, bool) {
+	if h.root == nil {
+		var zero T
+		return zero, false
+	}
+	top := h.root
+	h.root = h.combine(top.child)
+	h.size--
+	top.child, top.removed = nil, true
+	return top.value, true
+}
+
+// Peek returns the smallest element without removing it.
+func (h *Heap[T]) Peek() (T, bool) {
+	if h.root == nil {
+		var zero T
+		return zero, false
+	}
+	return h.root.value, true
+}
+
+// DecreaseKey lowers the value of the element behind handle by cutting
+// its subtree and melding it with the root.
+func (h *Heap[T]) DecreaseKey(handle heap.Handle[T], value T) error {
+	n, ok := handle.(*node[T])
+	if !ok || n.removed {
+		return heap.ErrInvalidHandle
+	}
+	if h.compare(value, n.value) > 0 {
+		return heap.ErrKeyIncreased
+	}
+	n.value = value
+	if n == h.root {
+		return nil
+	}
+	if n.prev.child == n {
+		n.prev.child = n.sibling
+	} else {
+		n.prev.sibling = n.sibling
+	}
+	if n.sibling != nil {
+		n.sibling.prev = n.prev
+	}
+	n.prev, n.sibling = nil, nil
+	h.root = h.meld(h.root, n)
+	return nil
+}
+
+// Merge moves every element of other into h. Merging two pairing heaps
+// is O(1).
+func (h *Heap[T]) Merge(other heap.PriorityQueue[T]) {
+	o, ok := other.(*Heap[T])
+	if !ok {
+		for !other.IsEmpty() {
+			value, _ := other.Pop()
+			h.Push(value)
+		}
+		return
+	}
+	if o == h || o.root == nil {
+		return
+	}
+	h.root = h.meld(h.root, o.root)
+	h.size += o.size
+	o.root, o.size = nil, 0
+}
+
+// Len returns the number of elements in the heap.
+func (h *Heap[T]) Len() int {
+	return h.size
+}
+
+// IsEmpty checks if the heap is empty.
+func (h *Heap[T]) IsEmpty() bool {
+	return h.size == 0
+}
+
+// Clear removes all elements from the heap.
+func (h *Heap[T]) Clear() {
+	h.each(func(n *node[T]) { n.removed = true })
+	h.root, h.size = nil, 0
+}
+
+// Values returns the elements in no particular order.
+func (h *Heap[T]) Values() []T {
+	values := make([]T, 0, h.size)
+	h.each(func(n *node[T]) { values = append(values, n.value) })
+	return values
+}
+
+// meld links two heap roots, making the larger a child of the smaller.
+func (h *Heap[T]) meld(a, b *node[T]) *node[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if h.compare(b.value, a.value) < 0 {
+		a, b = b, a
+	}
+	b.prev = a
+	b.sibling = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	a.child = b
+	return a
+}
+
+// combine melds a sibling list into one heap with the two-pass scheme:
+// pairs from left to right, then the pairs from right to left.
+func (h *Heap[T]) combine(first *node[T]) *node[T] {
+	pairs := h.scratch[:0]
+	for first != nil {
+		a, b := first, first.sibling
+		if b == nil {
+			first = nil
+		} else {
+			first = b.sibling
+			b.prev, b.sibling = nil, nil
+		}
+		a.prev, a.sibling = nil, nil
+		pairs = append(pairs, h.meld(a, b))
+	}
+	var root *node[T]
+	for i := len(pairs) - 1; i >= 0; i-- {
+		root = h.meld(pairs[i], root)
+		pairs[i] = nil
+	}
+	h.scratch = pairs
+	return root
+}
+
+// each calls fn for every node, parents before children.
+func (h *Heap[T]) each(fn func(n *node[T])) {
+	if h.root == nil {
+		return
+	}
+	stack := []*node[T]{h.root}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		fn(n)
+		for c := n.child; c != nil; c = c.sibling {
+			stack = append(stack, c)
+		}
+	}
+}
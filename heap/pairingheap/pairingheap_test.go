@@ -0,0 +1,25 @@
+package pairingheap_test
+
+import (
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/heap/pairingheap"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeap_DecreaseKeyOfRootAndChildren(t *testing.T) {
+	h := pairingheap.NewOrdered[int]()
+	root := h.Push(10)
+	first := h.Push(20)
+	second := h.Push(30)
+
+	assert.NoError(t, h.DecreaseKey(root, 5))
+	assert.NoError(t, h.DecreaseKey(second, 1))
+	assert.NoError(t, h.DecreaseKey(first, 3))
+
+	for _, want := range []int{1, 3, 5} {
+		got, _ := h.Pop()
+		assert.Equal(t, want, got)
+	}
+	assert.True(t, h.IsEmpty())
+}
@@ -0,0 +1,259 @@
+package arraylist
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/list"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set"
+)
+
+// ArrayList implements the List interface on a growable slice.
+// Unlike a plain slice it grows according to an explicit GrowthPolicy
+// and lets callers reserve or release capacity.
+type ArrayList[T comparable] struct {
+	elements []T
+	growth   GrowthPolicy
+}
+
+// New creates an empty ArrayList using DoublingGrowth.
+func New[T comparable]() *ArrayList[T] {
+	return NewWithCapacity[T](0)
+}
+
+// NewWithCapacity creates an empty ArrayList with room for capacity elements.
+func NewWithCapacity[T comparable](capacity int) *ArrayList[T] {
+	return &ArrayList[T]{
+		elements: make([]T, 0, max(capacity, 0)),
+		growth:   DoublingGrowth,
+	}
+}
+
+// FromSlice creates an ArrayList holding a copy of values.
+func FromSlice[T comparable](values []T) *ArrayList[T] {
+	l := NewWithCapacity[T](len(values))
+	l.elements = append(l.elements, values...)
+	return l
+}
+
+// FromSet creates an ArrayList holding the elements of s, in the order
+// s.ToSlice returns them.
+func FromSet[T interface {
+	comparable
+	set.Setable
+}](s set.Set[T]) *ArrayList[T] {
+	return FromSlice(s.ToSlice())
+}
+
+// SetGrowthPolicy replaces the policy used when the list runs out of room.
+// Passing nil restores DoublingGrowth.
+func (l *ArrayList[T]) SetGrowthPolicy(policy GrowthPolicy) {
+	if policy == nil {
+		policy = DoublingGrowth
+	}
+	l.growth = policy
+}
+
+// Capacity returns the number of elements the list can hold without growing.
+func (l *ArrayList[T]) Capacity() int {
+	return cap(l.elements)
+}
+
+// EnsureCapacity grows the list, if needed, so that it can hold at least
+// capacity elements without further allocation.
+func (l *ArrayList[T]) EnsureCapacity(capacity int) {
+	if capacity > cap(l.elements) {
+		l.resize(capacity)
+	}
+}
+
+// TrimToSize releases unused capacity.
+func (l *ArrayList[T]) TrimToSize() {
+	if cap(l.elements) > len(l.elements) {
+		l.resize(len(l.elements))
+	}
+}
+
+// Add appends one or more elements to the end of the list.
+func (l *ArrayList[T]) Add(values ...T) {
+	l.reserve(len(values))
+	l.elements = append(l.elements, values...)
+}
+
+// Insert places the elements at index, shifting later elements right.
+func (l *ArrayList[T]) Insert(index int, values ...T) {
+	if index < 0 || index > len(l.elements) {
+		panic(outOfRange(index, len(l.elements)))
+	}
+	l.reserve(len(values))
+	l.elements = slices.Insert(l.elements, index, values...)
+}
+
+// Get returns the element at index.
+func (l *ArrayList[T]) Get(index int) T {
+	l.check(index)
+	return l.elements[index]
+}
+
+// Set replaces the element at index and returns the previous one.
+func (l *ArrayList[T]) Set(index int, value T) T {
+	l.check(index)
+	old := l.elements[index]
+	l.elements[index] = value
+	return old
+}
+
+// RemoveAt deletes the element at index and returns it.
+func (l *ArrayList[T]) RemoveAt(index int) T {
+	l.check(index)
+	value := l.elements[index]
+	l.elements = slices.Delete(l.elements, index, index+1)
+	return value
+}
+
+// Remove deletes the first occurrence of value.
+func (l *ArrayList[T]) Remove(value T) bool {
+	index := l.IndexOf(value)
+	if index < 0 {
+		return false
+	}
+	l.RemoveAt(index)
+	return true
+}
+
+// IndexOf returns the index of the first occurrence of value, or -1.
+func (l *ArrayList[T]) IndexOf(value T) int {
+	return slices.Index(l.elements, value)
+}
+
+// LastIndexOf returns the index of the last occurrence of value, or -1.
+func (l *ArrayList[T]) LastIndexOf(value T) int {
+	for i := len(l.elements) - 1; i >= 0; i-- {
+		if l.elements[i] == value {
+			return i
+		}
+	}
+	return -1
+}
+
+// Contains checks if all specified elements are in the list.
+func (l *ArrayList[T]) Contains(values ...T) bool {
+	for _, value := range values {
+		if l.IndexOf(value) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Size returns the number of elements in the list.
+func (l *ArrayList[T]) Size() int {
+	return len(l.elements)
+}
+
+// IsEmpty checks if the list is empty.
+func (l *ArrayList[T]) IsEmpty() bool {
+	return len(l.elements) == 0
+}
+
+// Clear removes all elements but keeps the capacity.
+func (l *ArrayList[T]) Clear() {
+	clear(l.elements)
+	l.elements = l.elements[:0]
+}
+
+// Sublist returns a new ArrayList holding the elements in [from, to).
+func (l *ArrayList[T]) Sublist(from, to int) list.List[T] {
+	if from < 0 || to > len(l.elements) || from > to {
+		panic(fmt.Sprintf("arraylist: invalid range [%d, %d) with size %d", from, to, len(l.elements)))
+	}
+	sub := FromSlice(l.elements[from:to])
+	sub.growth = l.growth
+	return sub
+}
+
+// Sort orders the list by compare, keeping equal elements in order.
+func (l *ArrayList[T]) Sort(compare func(a, b T) int) {
+	slices.SortStableFunc(l.elements, compare)
+}
+
+// Reverse reverses the order of the elements in place.
+func (l *ArrayList[T]) Reverse() {
+	slices.Reverse(l.elements)
+}
+
+// Iterator returns an iterator over the elements from first to last.
+func (l *ArrayList[T]) Iterator() list.Iterator[T] {
+	return &iterator[T]{list: l}
+}
+
+// Each calls fn for every element in order until fn returns false.
+func (l *ArrayList[T]) Each(fn func(index int, value T) bool) {
+	for i, value := range l.elements {
+		if !fn(i, value) {
+			return
+		}
+	}
+}
+
+// ToSlice returns a slice containing all elements in order.
+func (l *ArrayList[T]) ToSlice() []T {
+	return slices.Clone(l.elements)
+}
+
+// ToString returns a string representation of the list.
+func (l *ArrayList[T]) ToString() string {
+	var sb strings.Builder
+	sb.WriteString("[")
+	for i, value := range l.elements {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v", value))
+	}
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// reserve makes room for n more elements using the growth policy.
+func (l *ArrayList[T]) reserve(n int) {
+	required := len(l.elements) + n
+	if required > cap(l.elements) {
+		l.resize(max(l.growth(cap(l.elements), required), required))
+	}
+}
+
+// resize moves the elements into a backing array of the given capacity.
+func (l *ArrayList[T]) resize(capacity int) {
+	elements := make([]T, len(l.elements), capacity)
+	copy(elements, l.elements)
+	l.elements = elements
+}
+
+// check panics if index does not address an element.
+func (l *ArrayList[T]) check(index int) {
+	if index < 0 || index >= len(l.elements) {
+		panic(outOfRange(index, len(l.elements)))
+	}
+}
+
+func outOfRange(index, size int) string {
+	return fmt.Sprintf("arraylist: index %d out of range with size %d", index, size)
+}
+
+// iterator walks an ArrayList by index.
+type iterator[T comparable] struct {
+	list  *ArrayList[T]
+	index int
+}
+
+func (it *iterator[T]) HasNext() bool {
+	return it.index < it.list.Size()
+}
+
+func (it *iterator[T]) Next() T {
+	value := it.list.Get(it.index)
+	it.index++
+	return value
+}
@@ -0,0 +1,105 @@
+package arraylist_test
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/list"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/list/arraylist"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set/hashset"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArrayList_BasicOperations(t *testing.T) {
+	var l list.List[int] = arraylist.New[int]()
+
+	l.Add(1, 2, 3)
+	l.Insert(0, 0)
+	l.Insert(4, 5)
+	l.Insert(4, 4)
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5}, l.ToSlice())
+	assert.Equal(t, 6, l.Size())
+
+	assert.Equal(t, 2, l.Get(2))
+	assert.Equal(t, 2, l.Set(2, 20))
+	assert.Equal(t, 20, l.RemoveAt(2))
+	assert.True(t, l.Remove(0))
+	assert.False(t, l.Remove(42))
+	assert.Equal(t, []int{1, 3, 4, 5}, l.ToSlice())
+
+	assert.Equal(t, 1, l.IndexOf(3))
+	assert.Equal(t, -1, l.IndexOf(42))
+	assert.True(t, l.Contains(1, 5))
+	assert.False(t, l.Contains(1, 42))
+	assert.Equal(t, "[1, 3, 4, 5]", l.ToString())
+
+	l.Clear()
+	assert.True(t, l.IsEmpty())
+}
+
+func TestArrayList_OutOfRange(t *testing.T) {
+	l := arraylist.FromSlice([]int{1, 2})
+
+	assert.Panics(t, func() { l.Get(2) })
+	assert.Panics(t, func() { l.Set(-1, 0) })
+	assert.Panics(t, func() { l.RemoveAt(5) })
+	assert.Panics(t, func() { l.Insert(3, 0) })
+	assert.Panics(t, func() { l.Sublist(1, 0) })
+}
+
+func TestArrayList_SublistSortReverse(t *testing.T) {
+	l := arraylist.FromSlice([]int{5, 3, 1, 4, 2, 3})
+
+	sub := l.Sublist(1, 4)
+	assert.Equal(t, []int{3, 1, 4}, sub.ToSlice())
+	sub.Set(0, 30)
+	assert.Equal(t, 3, l.Get(1))
+
+	l.Sort(cmp.Compare[int])
+	assert.Equal(t, []int{1, 2, 3, 3, 4, 5}, l.ToSlice())
+	assert.Equal(t, 3, l.LastIndexOf(3))
+
+	l.Reverse()
+	assert.Equal(t, []int{5, 4, 3, 3, 2, 1}, l.ToSlice())
+}
+
+func TestArrayList_StableSort(t *testing.T) {
+	type pair struct{ key, order int }
+	l := arraylist.FromSlice([]pair{{2, 0}, {1, 1}, {2, 2}, {1, 3}})
+
+	l.Sort(func(a, b pair) int { return cmp.Compare(a.key, b.key) })
+	assert.Equal(t, []pair{{1, 1}, {1, 3}, {2, 0}, {2, 2}}, l.ToSlice())
+}
+
+func TestArrayList_Iteration(t *testing.T) {
+	l := arraylist.FromSlice([]string{"a", "b", "c"})
+
+	var values []string
+	for it := l.Iterator(); it.HasNext(); {
+		values = append(values, it.Next())
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, values)
+
+	var indexes []int
+	l.Each(func(index int, _ string) bool {
+		indexes = append(indexes, index)
+		return index < 1
+	})
+	assert.Equal(t, []int{0, 1}, indexes)
+
+	it := arraylist.New[string]().Iterator()
+	assert.False(t, it.HasNext())
+	assert.Panics(t, func() { it.Next() })
+}
+
+func TestArrayList_FromSet(t *testing.T) {
+	s := hashset.NewHashSet[*mocks.MockSetable]()
+	item1 := mocks.NewMockSetable("item1")
+	item2 := mocks.NewMockSetable("item2")
+	s.Add(item1, item2)
+
+	l := arraylist.FromSet[*mocks.MockSetable](s)
+	assert.Equal(t, 2, l.Size())
+	assert.True(t, l.Contains(item1, item2))
+}
@@ -0,0 +1,28 @@
+package arraylist
+
+// GrowthPolicy returns the new capacity for a list that holds capacity
+// elements and needs room for required. The result must be at least
+// required; smaller results are raised to it.
+type GrowthPolicy func(capacity, required int) int
+
+// DoublingGrowth doubles the capacity, which keeps appends amortized O(1).
+// It is the default policy.
+func DoublingGrowth(capacity, required int) int {
+	return max(capacity*2, required, 4)
+}
+
+// FactorGrowth grows the capacity by factor, which must be above 1.
+// Smaller factors waste less memory at the cost of more copying.
+func FactorGrowth(factor float64) GrowthPolicy {
+	return func(capacity, required int) int {
+		return max(int(float64(capacity)*factor), required, 4)
+	}
+}
+
+// LinearGrowth grows the capacity by a fixed step. Appends become O(n)
+// amortized, but the list never overshoots its size by more than step.
+func LinearGrowth(step int) GrowthPolicy {
+	return func(capacity, required int) int {
+		return max(capacity+step, required)
+	}
+}
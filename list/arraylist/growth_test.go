@@ -0,0 +1,61 @@
+package arraylist_test
+
+import (
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/list/arraylist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArrayList_DoublingGrowth(t *testing.T) {
+	l := arraylist.New[int]()
+	assert.Equal(t, 0, l.Capacity())
+
+	l.Add(1)
+	assert.Equal(t, 4, l.Capacity())
+	l.Add(2, 3, 4, 5)
+	assert.Equal(t, 8, l.Capacity())
+
+	// A bulk add larger than the doubled capacity gets exactly what it needs
+	l.Add(make([]int, 20)...)
+	assert.Equal(t, 25, l.Capacity())
+}
+
+func TestArrayList_LinearGrowth(t *testing.T) {
+	l := arraylist.NewWithCapacity[int](2)
+	l.SetGrowthPolicy(arraylist.LinearGrowth(3))
+
+	l.Add(1, 2, 3)
+	assert.Equal(t, 5, l.Capacity())
+	l.Add(4, 5, 6)
+	assert.Equal(t, 8, l.Capacity())
+}
+
+func TestArrayList_FactorGrowth(t *testing.T) {
+	l := arraylist.NewWithCapacity[int](10)
+	l.SetGrowthPolicy(arraylist.FactorGrowth(1.5))
+
+	l.Add(make([]int, 11)...)
+	assert.Equal(t, 15, l.Capacity())
+
+	l.SetGrowthPolicy(nil)
+	l.Add(make([]int, 5)...)
+	assert.Equal(t, 30, l.Capacity())
+}
+
+func TestArrayList_CapacityControl(t *testing.T) {
+	l := arraylist.New[int]()
+	l.EnsureCapacity(100)
+	assert.Equal(t, 100, l.Capacity())
+
+	l.Add(1, 2, 3)
+	l.EnsureCapacity(10)
+	assert.Equal(t, 100, l.Capacity())
+
+	l.TrimToSize()
+	assert.Equal(t, 3, l.Capacity())
+	assert.Equal(t, []int{1, 2, 3}, l.ToSlice())
+
+	l.Clear()
+	assert.Equal(t, 3, l.Capacity())
+}
@@ -0,0 +1,281 @@
+package linkedlist
+
+// DoublyNode is an element of a DoublyLinkedList. The node is the handle
+// used to remove or move the element in O(1).
+type DoublyNode[T any] struct {
+	Value T
+	prev  *DoublyNode[T]
+	next  *DoublyNode[T]
+}
+
+// Next returns the following node, or nil at the back of the list.
+func (n *DoublyNode[T]) Next() *DoublyNode[T] {
+	return n.next
+}
+
+// Prev returns the preceding node, or nil at the front of the list.
+func (n *DoublyNode[T]) Prev() *DoublyNode[T] {
+	return n.prev
+}
+
+// DoublyLinkedList is a generic doubly linked list. Unlike container/list
+// it stores values without boxing them in an interface. The zero value is
+// an empty list ready to use.
+//
+// Nodes do not remember which list they belong to, which keeps Splice
+// O(1); passing a node of another list to a method is a programming error.
+type DoublyLinkedList[T any] struct {
+	head *DoublyNode[T]
+	tail *DoublyNode[T]
+	size int
+}
+
+// NewDoubly creates an empty DoublyLinkedList.
+func NewDoubly[T any]() *DoublyLinkedList[T] {
+	return &DoublyLinkedList[T]{}
+}
+
+// Len returns the number of elements in the list.
+func (l *DoublyLinkedList[T]) Len() int {
+	return l.size
+}
+
+// Front returns the first node, or nil if the list is empty.
+func (l *DoublyLinkedList[T]) Front() *DoublyNode[T] {
+	return l.head
+}
+
+// Back returns the last node, or nil if the list is empty.
+func (l *DoublyLinkedList[T]) Back() *DoublyNode[T] {
+	return l.tail
+}
+
+// PushFront inserts value at the front and returns its node.
+func (l *DoublyLinkedList[T]) PushFront(value T) *DoublyNode[T] {
+	n := &DoublyNode[T]{Value: value}
+	l.linkAfter(n, nil)
+	return n
+}
+
+// PushBack inserts value at the back and returns its node.
+func (l *DoublyLinkedList[T]) PushBack(value T) *DoublyNode[T] {
+	n := &DoublyNode[T]{Value: value}
+	l.linkAfter(n, l.tail)
+	return n
+}
+
+// InsertBefore inserts value right before mark and returns its node.
+func (l *DoublyLinkedList[T]) InsertBefore(value T, mark *DoublyNode[T]) *DoublyNode[T] {
+	n := &DoublyNode[T]{Value: value}
+	l.linkAfter(n, mark.prev)
+	return n
+}
+
+// InsertAfter inserts value right after mark and returns its node.
+func (l *DoublyLinkedList[T]) InsertAfter(value T, mark *DoublyNode[T]) *DoublyNode[T] {
+	n := &DoublyNode[T]{Value: value}
+	l.linkAfter(n, mark)
+	return n
+}
+
+// Remove unlinks n from the list and returns its value.
+func (l *DoublyLinkedList[T]) Remove(n *DoublyNode[T]) T {
+	l.unlink(n)
+	return n.Value
+}
+
+// MoveToFront moves n to the front of the list.
+func (l *DoublyLinkedList[T]) MoveToFront(n *DoublyNode[T]) {
+	if l.head != n {
+		l.unlink(n)
+		l.linkAfter(n, nil)
+	}
+}
+
+// MoveToBack moves n to the back of the list.
+func (l *DoublyLinkedList[T]) MoveToBack(n *DoublyNode[T]) {
+	if l.tail != n {
+		l.unlink(n)
+		l.linkAfter(n, l.tail)
+	}
+}
+
+// MoveBefore moves n right before mark.
+func (l *DoublyLinkedList[T]) MoveBefore(n, mark *DoublyNode[T]) {
+	if n != mark && n.next != mark {
+		l.unlink(n)
+		l.linkAfter(n, mark.prev)
+	}
+}
+
+// MoveAfter moves n right after mark.
+func (l *DoublyLinkedList[T]) MoveAfter(n, mark *DoublyNode[T]) {
+	if n != mark && mark.next != n {
+		l.unlink(n)
+		l.linkAfter(n, mark)
+	}
+}
+
+// Splice moves every node of other into l right after mark, or to the
+// front of l if mark is nil, leaving other empty. It runs in O(1) and the
+// moved nodes stay valid handles.
+func (l *DoublyLinkedList[T]) Splice(mark *DoublyNode[T], other *DoublyLinkedList[T]) {
+	if other == l || other.size == 0 {
+		return
+	}
+	first, last := other.head, other.tail
+	var next *DoublyNode[T]
+	if mark == nil {
+		next = l.head
+		l.head = first
+	} else {
+		next = mark.next
+		mark.next = first
+	}
+	first.prev = mark
+	last.next = next
+	if next == nil {
+		l.tail = last
+	} else {
+		next.prev = last
+	}
+	l.size += other.size
+	other.Clear()
+}
+
+// Reverse reverses the order of the nodes in place.
+func (l *DoublyLinkedList[T]) Reverse() {
+	for n := l.head; n != nil; n = n.prev {
+		n.next, n.prev = n.prev, n.next
+	}
+	l.head, l.tail = l.tail, l.head
+}
+
+// Sort orders the list by compare using a stable merge sort.
+// Nodes are relinked rather than copied, so handles stay valid.
+func (l *DoublyLinkedList[T]) Sort(compare func(a, b T) int) {
+	if l.size < 2 {
+		return
+	}
+	l.head = mergeSortDoubly(l.head, l.size, compare)
+	l.relink()
+}
+
+// Merge moves the nodes of other into l, assuming both are sorted by
+// compare, so that l stays sorted. other is left empty. On ties the nodes
+// of l come first.
+func (l *DoublyLinkedList[T]) Merge(other *DoublyLinkedList[T], compare func(a, b T) int) {
+	if other == l || other.size == 0 {
+		return
+	}
+	l.head = mergeDoubly(l.head, other.head, compare)
+	l.size += other.size
+	other.Clear()
+	l.relink()
+}
+
+// Clear removes all elements from the list.
+func (l *DoublyLinkedList[T]) Clear() {
+	l.head, l.tail, l.size = nil, nil, 0
+}
+
+// Each calls fn for every value from front to back until fn returns false.
+func (l *DoublyLinkedList[T]) Each(fn func(value T) bool) {
+	for n := l.head; n != nil; n = n.next {
+		if !fn(n.Value) {
+			return
+		}
+	}
+}
+
+// Values returns a slice of all values from front to back.
+func (l *DoublyLinkedList[T]) Values() []T {
+	values := make([]T, 0, l.size)
+	for n := l.head; n != nil; n = n.next {
+		values = append(values, n.Value)
+	}
+	return values
+}
+
+// linkAfter inserts n after mark, or at the front if mark is nil.
+func (l *DoublyLinkedList[T]) linkAfter(n, mark *DoublyNode[T]) {
+	n.prev = mark
+	if mark == nil {
+		n.next = l.head
+		l.head = n
+	} else {
+		n.next = mark.next
+		mark.next = n
+	}
+	if n.next == nil {
+		l.tail = n
+	} else {
+		n.next.prev = n
+	}
+	l.size++
+}
+
+// unlink detaches n from its neighbours.
+func (l *DoublyLinkedList[T]) unlink(n *DoublyNode[T]) {
+	if n.prev == nil {
+		l.head = n.next
+	} else {
+		n.prev.next = n.next
+	}
+	if n.next == nil {
+		l.tail = n.prev
+	} else {
+		n.next.prev = n.prev
+	}
+	n.prev, n.next = nil, nil
+	l.size--
+}
+
+// relink restores prev pointers and the tail after the next chain changed.
+func (l *DoublyLinkedList[T]) relink() {
+	var prev *DoublyNode[T]
+	for n := l.head; n != nil; n = n.next {
+		n.prev = prev
+		prev = n
+	}
+	l.tail = prev
+}
+
+// mergeSortDoubly sorts the chain of size nodes starting at head by next
+// pointers only and returns the new head.
+func mergeSortDoubly[T any](head *DoublyNode[T], size int, compare func(a, b T) int) *DoublyNode[T] {
+	if size < 2 {
+		if head != nil {
+			head.next = nil
+		}
+		return head
+	}
+	half := size / 2
+	mid := head
+	for i := 0; i < half; i++ {
+		mid = mid.next
+	}
+	left := mergeSortDoubly(head, half, compare)
+	right := mergeSortDoubly(mid, size-half, compare)
+	return mergeDoubly(left, right, compare)
+}
+
+// mergeDoubly merges two sorted next chains, preferring a on ties.
+func mergeDoubly[T any](a, b *DoublyNode[T], compare func(a, b T) int) *DoublyNode[T] {
+	var head DoublyNode[T]
+	tail := &head
+	for a != nil && b != nil {
+		if compare(b.Value, a.Value) < 0 {
+			tail.next, b = b, b.next
+		} else {
+			tail.next, a = a, a.next
+		}
+		tail = tail.next
+	}
+	if a != nil {
+		tail.next = a
+	} else {
+		tail.next = b
+	}
+	return head.next
+}
@@ -0,0 +1,133 @@
+package linkedlist_test
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/list/linkedlist"
+	"github.com/stretchr/testify/assert"
+)
+
+// backwards collects the values of l from back to front.
+func backwards[T any](l *linkedlist.DoublyLinkedList[T]) []T {
+	var values []T
+	for n := l.Back(); n != nil; n = n.Prev() {
+		values = append(values, n.Value)
+	}
+	return values
+}
+
+func TestDoublyLinkedList_InsertAndRemove(t *testing.T) {
+	var l linkedlist.DoublyLinkedList[int]
+
+	two := l.PushBack(2)
+	l.PushFront(1)
+	four := l.PushBack(4)
+	l.InsertBefore(3, four)
+	l.InsertAfter(5, four)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, l.Values())
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, backwards(&l))
+
+	assert.Equal(t, 2, l.Remove(two))
+	assert.Equal(t, 4, l.Remove(four))
+	assert.Equal(t, []int{1, 3, 5}, l.Values())
+	assert.Equal(t, []int{5, 3, 1}, backwards(&l))
+	assert.Equal(t, 3, l.Len())
+
+	l.Remove(l.Front())
+	l.Remove(l.Back())
+	l.Remove(l.Front())
+	assert.Equal(t, 0, l.Len())
+	assert.Nil(t, l.Front())
+	assert.Nil(t, l.Back())
+}
+
+func TestDoublyLinkedList_Move(t *testing.T) {
+	l := linkedlist.NewDoubly[string]()
+	a := l.PushBack("a")
+	b := l.PushBack("b")
+	c := l.PushBack("c")
+
+	l.MoveToFront(c)
+	assert.Equal(t, []string{"c", "a", "b"}, l.Values())
+	l.MoveToBack(c)
+	assert.Equal(t, []string{"a", "b", "c"}, l.Values())
+	l.MoveBefore(c, a)
+	assert.Equal(t, []string{"c", "a", "b"}, l.Values())
+	l.MoveAfter(c, b)
+	assert.Equal(t, []string{"a", "b", "c"}, l.Values())
+	l.MoveAfter(a, a)
+	assert.Equal(t, []string{"c", "b", "a"}, backwards(l))
+}
+
+func TestDoublyLinkedList_Splice(t *testing.T) {
+	l := linkedlist.NewDoubly[int]()
+	one := l.PushBack(1)
+	l.PushBack(5)
+
+	other := linkedlist.NewDoubly[int]()
+	other.PushBack(2)
+	three := other.PushBack(3)
+	l.Splice(one, other)
+	assert.Equal(t, []int{1, 2, 3, 5}, l.Values())
+	assert.Equal(t, 0, other.Len())
+
+	// Spliced nodes remain valid handles
+	l.InsertAfter(4, three)
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, backwards(l))
+
+	front := linkedlist.NewDoubly[int]()
+	front.PushBack(0)
+	l.Splice(nil, front)
+	tail := linkedlist.NewDoubly[int]()
+	tail.PushBack(6)
+	l.Splice(l.Back(), tail)
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6}, l.Values())
+	assert.Equal(t, 6, l.Back().Value)
+	assert.Equal(t, 7, l.Len())
+}
+
+func TestDoublyLinkedList_Reverse(t *testing.T) {
+	l := linkedlist.NewDoubly[int]()
+	l.Reverse()
+	for i := 1; i <= 4; i++ {
+		l.PushBack(i)
+	}
+	l.Reverse()
+	assert.Equal(t, []int{4, 3, 2, 1}, l.Values())
+	assert.Equal(t, []int{1, 2, 3, 4}, backwards(l))
+}
+
+func TestDoublyLinkedList_SortIsStable(t *testing.T) {
+	type item struct{ key, seq int }
+	l := linkedlist.NewDoubly[item]()
+	keys := []int{5, 3, 9, 3, 1, 5, 7, 3}
+	nodes := make([]*linkedlist.DoublyNode[item], len(keys))
+	for i, key := range keys {
+		nodes[i] = l.PushBack(item{key, i})
+	}
+
+	l.Sort(func(a, b item) int { return cmp.Compare(a.key, b.key) })
+	assert.Equal(t, []item{{1, 4}, {3, 1}, {3, 3}, {3, 7}, {5, 0}, {5, 5}, {7, 6}, {9, 2}}, l.Values())
+	assert.Equal(t, item{9, 2}, l.Back().Value)
+
+	// Handles survive sorting
+	l.Remove(nodes[3])
+	assert.Equal(t, 7, len(backwards(l)))
+}
+
+func TestDoublyLinkedList_Merge(t *testing.T) {
+	a := linkedlist.NewDoubly[int]()
+	b := linkedlist.NewDoubly[int]()
+	for _, v := range []int{1, 4, 6} {
+		a.PushBack(v)
+	}
+	for _, v := range []int{2, 3, 7, 8} {
+		b.PushBack(v)
+	}
+
+	a.Merge(b, cmp.Compare[int])
+	assert.Equal(t, []int{1, 2, 3, 4, 6, 7, 8}, a.Values())
+	assert.Equal(t, []int{8, 7, 6, 4, 3, 2, 1}, backwards(a))
+	assert.Equal(t, 0, b.Len())
+}
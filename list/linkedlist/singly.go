@@ -0,0 +1,221 @@
+package linkedlist
+
+// SinglyNode is an element of a SinglyLinkedList.
+type SinglyNode[T any] struct {
+	Value T
+	next  *SinglyNode[T]
+}
+
+// Next returns the following node, or nil at the back of the list.
+func (n *SinglyNode[T]) Next() *SinglyNode[T] {
+	return n.next
+}
+
+// SinglyLinkedList is a generic singly linked list with O(1) access to both
+// ends. Removal needs the preceding node, so it is done with RemoveAfter.
+// The zero value is an empty list ready to use.
+type SinglyLinkedList[T any] struct {
+	head *SinglyNode[T]
+	tail *SinglyNode[T]
+	size int
+}
+
+// NewSingly creates an empty SinglyLinkedList.
+func NewSingly[T any]() *SinglyLinkedList[T] {
+	return &SinglyLinkedList[T]{}
+}
+
+// Len returns the number of elements in the list.
+func (l *SinglyLinkedList[T]) Len() int {
+	return l.size
+}
+
+// Front returns the first node, or nil if the list is empty.
+func (l *SinglyLinkedList[T]) Front() *SinglyNode[T] {
+	return l.head
+}
+
+// Back returns the last node, or nil if the list is empty.
+func (l *SinglyLinkedList[T]) Back() *SinglyNode[T] {
+	return l.tail
+}
+
+// PushFront inserts value at the front and returns its node.
+func (l *SinglyLinkedList[T]) PushFront(value T) *SinglyNode[T] {
+	return l.InsertAfter(value, nil)
+}
+
+// PushBack inserts value at the back and returns its node.
+func (l *SinglyLinkedList[T]) PushBack(value T) *SinglyNode[T] {
+	return l.InsertAfter(value, l.tail)
+}
+
+// InsertAfter inserts value right after mark, or at the front if mark is
+// nil, and returns its node.
+func (l *SinglyLinkedList[T]) InsertAfter(value T, mark *SinglyNode[T]) *SinglyNode[T] {
+	n := &SinglyNode[T]{Value: value}
+	if mark == nil {
+		n.next = l.head
+		l.head = n
+	} else {
+		n.next = mark.next
+		mark.next = n
+	}
+	if n.next == nil {
+		l.tail = n
+	}
+	l.size++
+	return n
+}
+
+// PopFront removes the first element and returns its value.
+func (l *SinglyLinkedList[T]) PopFront() (T, bool) {
+	return l.RemoveAfter(nil)
+}
+
+// RemoveAfter removes the node following mark, or the front node if mark
+// is nil, and returns its value. It returns false if there is no such node.
+func (l *SinglyLinkedList[T]) RemoveAfter(mark *SinglyNode[T]) (T, bool) {
+	var n *SinglyNode[T]
+	if mark == nil {
+		n = l.head
+	} else {
+		n = mark.next
+	}
+	if n == nil {
+		var zero T
+		return zero, false
+	}
+	if mark == nil {
+		l.head = n.next
+	} else {
+		mark.next = n.next
+	}
+	if l.tail == n {
+		l.tail = mark
+	}
+	n.next = nil
+	l.size--
+	return n.Value, true
+}
+
+// Splice moves every node of other to the back of l in O(1), leaving
+// other empty.
+func (l *SinglyLinkedList[T]) Splice(other *SinglyLinkedList[T]) {
+	if other == l || other.size == 0 {
+		return
+	}
+	if l.tail == nil {
+		l.head = other.head
+	} else {
+		l.tail.next = other.head
+	}
+	l.tail = other.tail
+	l.size += other.size
+	other.Clear()
+}
+
+// Reverse reverses the order of the nodes in place.
+func (l *SinglyLinkedList[T]) Reverse() {
+	var prev *SinglyNode[T]
+	for n := l.head; n != nil; {
+		next := n.next
+		n.next = prev
+		prev, n = n, next
+	}
+	l.head, l.tail = l.tail, l.head
+}
+
+// Sort orders the list by compare using a stable merge sort.
+// Nodes are relinked rather than copied, so handles stay valid.
+func (l *SinglyLinkedList[T]) Sort(compare func(a, b T) int) {
+	if l.size < 2 {
+		return
+	}
+	l.head = mergeSortSingly(l.head, l.size, compare)
+	l.retail()
+}
+
+// Merge moves the nodes of other into l, assuming both are sorted by
+// compare, so that l stays sorted. other is left empty. On ties the nodes
+// of l come first.
+func (l *SinglyLinkedList[T]) Merge(other *SinglyLinkedList[T], compare func(a, b T) int) {
+	if other == l || other.size == 0 {
+		return
+	}
+	l.head = mergeSingly(l.head, other.head, compare)
+	l.size += other.size
+	other.Clear()
+	l.retail()
+}
+
+// Clear removes all elements from the list.
+func (l *SinglyLinkedList[T]) Clear() {
+	l.head, l.tail, l.size = nil, nil, 0
+}
+
+// Each calls fn for every value from front to back until fn returns false.
+func (l *SinglyLinkedList[T]) Each(fn func(value T) bool) {
+	for n := l.head; n != nil; n = n.next {
+		if !fn(n.Value) {
+			return
+		}
+	}
+}
+
+// Values returns a slice of all values from front to back.
+func (l *SinglyLinkedList[T]) Values() []T {
+	values := make([]T, 0, l.size)
+	for n := l.head; n != nil; n = n.next {
+		values = append(values, n.Value)
+	}
+	return values
+}
+
+// retail finds the tail again after the chain was relinked.
+func (l *SinglyLinkedList[T]) retail() {
+	n := l.head
+	for n != nil && n.next != nil {
+		n = n.next
+	}
+	l.tail = n
+}
+
+// mergeSortSingly sorts the chain of size nodes starting at head and
+// returns the new head.
+func mergeSortSingly[T any](head *SinglyNode[T], size int, compare func(a, b T) int) *SinglyNode[T] {
+	if size < 2 {
+		if head != nil {
+			head.next = nil
+		}
+		return head
+	}
+	half := size / 2
+	mid := head
+	for i := 0; i < half; i++ {
+		mid = mid.next
+	}
+	left := mergeSortSingly(head, half, compare)
+	right := mergeSortSingly(mid, size-half, compare)
+	return mergeSingly(left, right, compare)
+}
+
+// mergeSingly merges two sorted chains, preferring a on ties.
+func mergeSingly[T any](a, b *SinglyNode[T], compare func(a, b T) int) *SinglyNode[T] {
+	var head SinglyNode[T]
+	tail := &head
+	for a != nil && b != nil {
+		if compare(b.Value, a.Value) < 0 {
+			tail.next, b = b, b.next
+		} else {
+			tail.next, a = a, a.next
+		}
+		tail = tail.next
+	}
+	if a != nil {
+		tail.next = a
+	} else {
+		tail.next = b
+	}
+	return head.next
+}
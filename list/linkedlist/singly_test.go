@@ -0,0 +1,80 @@
+package linkedlist_test
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/list/linkedlist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSinglyLinkedList_InsertAndRemove(t *testing.T) {
+	var l linkedlist.SinglyLinkedList[int]
+
+	one := l.PushBack(1)
+	l.PushBack(3)
+	l.PushFront(0)
+	l.InsertAfter(2, one)
+	assert.Equal(t, []int{0, 1, 2, 3}, l.Values())
+	assert.Equal(t, 3, l.Back().Value)
+
+	value, ok := l.RemoveAfter(l.Front().Next().Next())
+	assert.True(t, ok)
+	assert.Equal(t, 3, value)
+	assert.Equal(t, 2, l.Back().Value)
+	_, ok = l.RemoveAfter(l.Back())
+	assert.False(t, ok)
+
+	value, ok = l.PopFront()
+	assert.True(t, ok)
+	assert.Equal(t, 0, value)
+	assert.Equal(t, []int{1, 2}, l.Values())
+
+	l.PopFront()
+	l.PopFront()
+	_, ok = l.PopFront()
+	assert.False(t, ok)
+	assert.Nil(t, l.Back())
+
+	l.PushBack(9)
+	assert.Equal(t, l.Front(), l.Back())
+}
+
+func TestSinglyLinkedList_SpliceAndReverse(t *testing.T) {
+	l := linkedlist.NewSingly[int]()
+	other := linkedlist.NewSingly[int]()
+	other.PushBack(1)
+	other.PushBack(2)
+	l.Splice(other)
+	assert.Equal(t, []int{1, 2}, l.Values())
+	assert.Equal(t, 0, other.Len())
+
+	other.PushBack(3)
+	l.Splice(other)
+	l.Reverse()
+	assert.Equal(t, []int{3, 2, 1}, l.Values())
+	assert.Equal(t, 1, l.Back().Value)
+
+	l.PushBack(0)
+	assert.Equal(t, []int{3, 2, 1, 0}, l.Values())
+}
+
+func TestSinglyLinkedList_SortAndMerge(t *testing.T) {
+	l := linkedlist.NewSingly[int]()
+	for _, v := range []int{8, 2, 6, 4, 1} {
+		l.PushBack(v)
+	}
+	l.Sort(cmp.Compare[int])
+	assert.Equal(t, []int{1, 2, 4, 6, 8}, l.Values())
+	assert.Equal(t, 8, l.Back().Value)
+
+	other := linkedlist.NewSingly[int]()
+	for _, v := range []int{3, 9} {
+		other.PushBack(v)
+	}
+	l.Merge(other, cmp.Compare[int])
+	assert.Equal(t, []int{1, 2, 3, 4, 6, 8, 9}, l.Values())
+	l.PushBack(10)
+	assert.Equal(t, 8, l.Len())
+	assert.Equal(t, 10, l.Back().Value)
+}
@@ -0,0 +1,82 @@
+package list
+
+import "github.com/alasgarovnamig/go-dsa-and-algorithm/set"
+
+// List defines the operations of an ordered, index-addressable collection.
+// Methods taking an index panic if it is out of range, like slice indexing.
+type List[T comparable] interface {
+	// Add appends one or more elements to the end of the list.
+	Add(values ...T)
+
+	// Insert places the elements at index, shifting later elements right.
+	// index may equal Size to append.
+	Insert(index int, values ...T)
+
+	// Get returns the element at index.
+	Get(index int) T
+
+	// Set replaces the element at index and returns the previous one.
+	Set(index int, value T) T
+
+	// RemoveAt deletes the element at index and returns it.
+	RemoveAt(index int) T
+
+	// Remove deletes the first occurrence of value and reports whether
+	// it was present.
+	Remove(value T) bool
+
+	// IndexOf returns the index of the first occurrence of value, or -1.
+	IndexOf(value T) int
+
+	// Contains checks if all specified elements are in the list.
+	Contains(values ...T) bool
+
+	// Size returns the number of elements in the list.
+	Size() int
+
+	// IsEmpty checks if the list is empty.
+	IsEmpty() bool
+
+	// Clear removes all elements from the list.
+	Clear()
+
+	// Sublist returns a new list holding the elements in [from, to).
+	Sublist(from, to int) List[T]
+
+	// Sort orders the list by compare, keeping equal elements in order.
+	Sort(compare func(a, b T) int)
+
+	// Reverse reverses the order of the elements in place.
+	Reverse()
+
+	// Iterator returns an iterator over the elements from first to last.
+	Iterator() Iterator[T]
+
+	// Each calls fn for every element in order until fn returns false.
+	Each(fn func(index int, value T) bool)
+
+	// ToSlice returns a slice containing all elements in order.
+	ToSlice() []T
+
+	// ToString returns a string representation of the list.
+	ToString() string
+}
+
+// Iterator walks the elements of a collection once.
+type Iterator[T any] interface {
+	// HasNext checks if there are more elements.
+	HasNext() bool
+
+	// Next returns the next element. It panics if there are none left.
+	Next() T
+}
+
+// ToSet adds every element of l to s and returns s.
+// Elements are deduplicated by their Hash, as usual for sets.
+func ToSet[T interface {
+	comparable
+	set.Setable
+}](l List[T], s set.Set[T]) set.Set[T] {
+	s.Add(l.ToSlice()...)
+	return s
+}
@@ -0,0 +1,21 @@
+package list_test
+
+import (
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/list"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/list/arraylist"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set/hashset"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToSet(t *testing.T) {
+	item1 := mocks.NewMockSetable("item1")
+	item2 := mocks.NewMockSetable("item2")
+	l := arraylist.FromSlice([]*mocks.MockSetable{item1, item2, mocks.NewMockSetable("item1")})
+
+	s := list.ToSet[*mocks.MockSetable](l, hashset.NewHashSet[*mocks.MockSetable]())
+	assert.Equal(t, 2, s.Size())
+	assert.True(t, s.Contains(item1, item2))
+}
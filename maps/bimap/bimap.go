@@ -0,0 +1,151 @@
+package bimap
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps"
+)
+
+// ErrValueAlreadyBound is returned by Put when the value is already
+// associated with a different key.
+var ErrValueAlreadyBound = errors.New("bimap: value already bound to another key")
+
+// BiMap is a map that keeps its values unique, so that it can be looked
+// up in both directions. Inverse returns a live view keyed by value.
+type BiMap[K comparable, V comparable] struct {
+	forward  map[K]V
+	backward map[V]K
+	inverse  *BiMap[V, K]
+}
+
+// New initializes a new BiMap together with its inverse view.
+func New[K comparable, V comparable]() *BiMap[K, V] {
+	forward := make(map[K]V)
+	backward := make(map[V]K)
+	m := &BiMap[K, V]{forward: forward, backward: backward}
+	m.inverse = &BiMap[V, K]{forward: backward, backward: forward, inverse: m}
+	return m
+}
+
+// Put associates value with key. It returns ErrValueAlreadyBound, and
+// leaves the map unchanged, if value already belongs to another key.
+func (m *BiMap[K, V]) Put(key K, value V) error {
+	if owner, exists := m.backward[value]; exists && owner != key {
+		return ErrValueAlreadyBound
+	}
+	m.put(key, value)
+	return nil
+}
+
+// ForcePut associates value with key, silently removing any entry that
+// previously held value.
+func (m *BiMap[K, V]) ForcePut(key K, value V) {
+	if owner, exists := m.backward[value]; exists && owner != key {
+		delete(m.forward, owner)
+	}
+	m.put(key, value)
+}
+
+// Get returns the value associated with key and whether it was found.
+func (m *BiMap[K, V]) Get(key K) (V, bool) {
+	value, exists := m.forward[key]
+	return value, exists
+}
+
+// Delete removes one or more keys, and their values, from the BiMap.
+func (m *BiMap[K, V]) Delete(keys ...K) {
+	for _, key := range keys {
+		if value, exists := m.forward[key]; exists {
+			delete(m.forward, key)
+			delete(m.backward, value)
+		}
+	}
+}
+
+// ContainsKey checks if the key is present.
+func (m *BiMap[K, V]) ContainsKey(key K) bool {
+	_, exists := m.forward[key]
+	return exists
+}
+
+// ContainsValue checks if the value is present.
+func (m *BiMap[K, V]) ContainsValue(value V) bool {
+	_, exists := m.backward[value]
+	return exists
+}
+
+// Inverse returns the live view of the BiMap keyed by value.
+// Changes made through either side are visible in the other.
+func (m *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	return m.inverse
+}
+
+// Size returns the number of entries in the BiMap.
+func (m *BiMap[K, V]) Size() int {
+	return len(m.forward)
+}
+
+// IsEmpty checks if the BiMap is empty.
+func (m *BiMap[K, V]) IsEmpty() bool {
+	return len(m.forward) == 0
+}
+
+// Clear removes all entries from the BiMap and its inverse.
+func (m *BiMap[K, V]) Clear() {
+	clear(m.forward)
+	clear(m.backward)
+}
+
+// Keys returns a slice containing all keys in the BiMap.
+func (m *BiMap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(m.forward))
+	for key := range m.forward {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Values returns a slice containing all values in the BiMap.
+func (m *BiMap[K, V]) Values() []V {
+	values := make([]V, 0, len(m.backward))
+	for value := range m.backward {
+		values = append(values, value)
+	}
+	return values
+}
+
+// Entries returns a slice containing all key-value pairs in the BiMap.
+func (m *BiMap[K, V]) Entries() []maps.Entry[K, V] {
+	entries := make([]maps.Entry[K, V], 0, len(m.forward))
+	for key, value := range m.forward {
+		entries = append(entries, maps.Entry[K, V]{Key: key, Value: value})
+	}
+	return entries
+}
+
+// ToString returns a string representation of the BiMap.
+func (m *BiMap[K, V]) ToString() string {
+	var sb strings.Builder
+	sb.WriteString("BiMap{")
+	first := true
+	for key, value := range m.forward {
+		if !first {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v=%v", key, value))
+		first = false
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// put stores the pair after the caller has resolved value conflicts.
+func (m *BiMap[K, V]) put(key K, value V) {
+	if old, exists := m.forward[key]; exists {
+		delete(m.backward, old)
+	}
+	m.forward[key] = value
+	m.backward[value] = key
+}
@@ -0,0 +1,84 @@
+package bimap_test
+
+import (
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps/bimap"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBiMap_BasicOperations(t *testing.T) {
+	m := bimap.New[string, int]()
+
+	assert.NoError(t, m.Put("ext-1", 1))
+	assert.NoError(t, m.Put("ext-2", 2))
+	assert.Equal(t, 2, m.Size())
+
+	value, ok := m.Get("ext-1")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+	assert.True(t, m.ContainsValue(2))
+
+	m.Delete("ext-1")
+	assert.False(t, m.ContainsKey("ext-1"))
+	assert.False(t, m.ContainsValue(1))
+	assert.Equal(t, []string{"ext-2"}, m.Keys())
+	assert.Equal(t, []int{2}, m.Values())
+	assert.Equal(t, []maps.Entry[string, int]{{Key: "ext-2", Value: 2}}, m.Entries())
+	assert.Equal(t, "BiMap{ext-2=2}", m.ToString())
+
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+	assert.True(t, m.Inverse().IsEmpty())
+}
+
+func TestBiMap_UniqueValues(t *testing.T) {
+	m := bimap.New[string, int]()
+	assert.NoError(t, m.Put("a", 1))
+
+	// Rebinding a key to the same value is allowed
+	assert.NoError(t, m.Put("a", 1))
+	assert.ErrorIs(t, m.Put("b", 1), bimap.ErrValueAlreadyBound)
+	assert.False(t, m.ContainsKey("b"))
+
+	// Replacing the value of a key releases the old value
+	assert.NoError(t, m.Put("a", 2))
+	assert.False(t, m.ContainsValue(1))
+	assert.NoError(t, m.Put("b", 1))
+}
+
+func TestBiMap_ForcePut(t *testing.T) {
+	m := bimap.New[string, int]()
+	_ = m.Put("a", 1)
+	_ = m.Put("b", 2)
+
+	m.ForcePut("c", 1)
+	assert.False(t, m.ContainsKey("a"))
+	key, _ := m.Inverse().Get(1)
+	assert.Equal(t, "c", key)
+
+	m.ForcePut("b", 1)
+	assert.Equal(t, 1, m.Size())
+	assert.False(t, m.ContainsValue(2))
+}
+
+func TestBiMap_Inverse(t *testing.T) {
+	m := bimap.New[string, int]()
+	inverse := m.Inverse()
+
+	_ = m.Put("a", 1)
+	key, ok := inverse.Get(1)
+	assert.True(t, ok)
+	assert.Equal(t, "a", key)
+
+	// Writes through the inverse reach the original map
+	assert.NoError(t, inverse.Put(2, "b"))
+	value, _ := m.Get("b")
+	assert.Equal(t, 2, value)
+	assert.ErrorIs(t, inverse.Put(3, "a"), bimap.ErrValueAlreadyBound)
+
+	inverse.Delete(1)
+	assert.False(t, m.ContainsKey("a"))
+	assert.Same(t, m, inverse.Inverse())
+}
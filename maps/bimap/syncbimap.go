@@ -0,0 +1,118 @@
+package bimap
+
+import (
+	"sync"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps"
+)
+
+// SyncBiMap is a thread-safe version of BiMap.
+// A SyncBiMap and its inverse share one lock.
+type SyncBiMap[K comparable, V comparable] struct {
+	m       *BiMap[K, V]
+	mu      *sync.RWMutex
+	inverse *SyncBiMap[V, K]
+}
+
+// NewSync initializes a new SyncBiMap together with its inverse view.
+func NewSync[K comparable, V comparable]() *SyncBiMap[K, V] {
+	m := New[K, V]()
+	mu := &sync.RWMutex{}
+	s := &SyncBiMap[K, V]{m: m, mu: mu}
+	s.inverse = &SyncBiMap[V, K]{m: m.Inverse(), mu: mu, inverse: s}
+	return s
+}
+
+// Put associates value with key, or returns ErrValueAlreadyBound.
+func (s *SyncBiMap[K, V]) Put(key K, value V) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.Put(key, value)
+}
+
+// ForcePut associates value with key, removing any entry that held value.
+func (s *SyncBiMap[K, V]) ForcePut(key K, value V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.ForcePut(key, value)
+}
+
+// Get returns the value associated with key and whether it was found.
+func (s *SyncBiMap[K, V]) Get(key K) (V, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Get(key)
+}
+
+// Delete removes one or more keys, and their values, from the SyncBiMap.
+func (s *SyncBiMap[K, V]) Delete(keys ...K) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.Delete(keys...)
+}
+
+// ContainsKey checks if the key is present.
+func (s *SyncBiMap[K, V]) ContainsKey(key K) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.ContainsKey(key)
+}
+
+// ContainsValue checks if the value is present.
+func (s *SyncBiMap[K, V]) ContainsValue(value V) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.ContainsValue(value)
+}
+
+// Inverse returns the live view of the SyncBiMap keyed by value.
+func (s *SyncBiMap[K, V]) Inverse() *SyncBiMap[V, K] {
+	return s.inverse
+}
+
+// Size returns the number of entries in the SyncBiMap.
+func (s *SyncBiMap[K, V]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Size()
+}
+
+// IsEmpty checks if the SyncBiMap is empty.
+func (s *SyncBiMap[K, V]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+// Clear removes all entries from the SyncBiMap and its inverse.
+func (s *SyncBiMap[K, V]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.Clear()
+}
+
+// Keys returns a slice containing all keys in the SyncBiMap.
+func (s *SyncBiMap[K, V]) Keys() []K {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Keys()
+}
+
+// Values returns a slice containing all values in the SyncBiMap.
+func (s *SyncBiMap[K, V]) Values() []V {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Values()
+}
+
+// Entries returns a slice containing all key-value pairs in the SyncBiMap.
+func (s *SyncBiMap[K, V]) Entries() []maps.Entry[K, V] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Entries()
+}
+
+// ToString returns a string representation of the SyncBiMap.
+func (s *SyncBiMap[K, V]) ToString() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return "Sync" + s.m.ToString()
+}
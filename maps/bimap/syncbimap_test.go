@@ -0,0 +1,58 @@
+package bimap_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps/bimap"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncBiMap_BasicOperations(t *testing.T) {
+	m := bimap.NewSync[string, int]()
+
+	assert.NoError(t, m.Put("a", 1))
+	assert.ErrorIs(t, m.Put("b", 1), bimap.ErrValueAlreadyBound)
+	m.ForcePut("b", 1)
+
+	key, ok := m.Inverse().Get(1)
+	assert.True(t, ok)
+	assert.Equal(t, "b", key)
+	assert.True(t, m.ContainsValue(1))
+	assert.Equal(t, []string{"b"}, m.Keys())
+	assert.Equal(t, []int{1}, m.Values())
+	assert.Len(t, m.Entries(), 1)
+	assert.Equal(t, "SyncBiMap{b=1}", m.ToString())
+
+	m.Inverse().Delete(1)
+	assert.False(t, m.ContainsKey("b"))
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+	assert.Same(t, m, m.Inverse().Inverse())
+}
+
+func TestSyncBiMap_ConcurrentAccess(t *testing.T) {
+	m := bimap.NewSync[int, int]()
+
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				m.ForcePut(g, i)
+				_, _ = m.Inverse().Get(i)
+				_ = m.Inverse().Put(i+1000, g)
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	// Every value still maps back to its key
+	for _, key := range m.Keys() {
+		value, _ := m.Get(key)
+		back, _ := m.Inverse().Get(value)
+		assert.Equal(t, key, back)
+	}
+	assert.Equal(t, m.Size(), m.Inverse().Size())
+}
@@ -0,0 +1,141 @@
+package hashmap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set"
+)
+
+// HashMap implements the Map interface using a Go map keyed by Hash.
+// Keys are unique by their Hash value, consistent with HashSet,
+// and entries are kept in no particular order.
+type HashMap[K set.Setable, V any] struct {
+	entries map[string]maps.Entry[K, V]
+}
+
+// NewHashMap creates and returns a new instance of HashMap.
+func NewHashMap[K set.Setable, V any]() *HashMap[K, V] {
+	return &HashMap[K, V]{
+		entries: make(map[string]maps.Entry[K, V]),
+	}
+}
+
+// Put associates value with key, replacing any previous value.
+func (m *HashMap[K, V]) Put(key K, value V) {
+	m.entries[key.Hash()] = maps.Entry[K, V]{Key: key, Value: value}
+}
+
+// Get returns the value associated with key and whether it was found.
+func (m *HashMap[K, V]) Get(key K) (V, bool) {
+	entry, exists := m.entries[key.Hash()]
+	return entry.Value, exists
+}
+
+// Delete removes one or more keys from the HashMap.
+func (m *HashMap[K, V]) Delete(keys ...K) {
+	for _, key := range keys {
+		delete(m.entries, key.Hash())
+	}
+}
+
+// ContainsKey checks if the key is present in the HashMap.
+func (m *HashMap[K, V]) ContainsKey(key K) bool {
+	_, exists := m.entries[key.Hash()]
+	return exists
+}
+
+// ComputeIfAbsent returns the value associated with key. If the key is
+// absent, fn is called to produce a value which is stored and returned.
+func (m *HashMap[K, V]) ComputeIfAbsent(key K, fn func(key K) V) V {
+	hash := key.Hash()
+	if entry, exists := m.entries[hash]; exists {
+		return entry.Value
+	}
+	value := fn(key)
+	m.entries[hash] = maps.Entry[K, V]{Key: key, Value: value}
+	return value
+}
+
+// Merge stores value under key if the key is absent, otherwise it stores
+// the result of fn applied to the existing value and value.
+// The stored value is returned.
+func (m *HashMap[K, V]) Merge(key K, value V, fn func(existing, value V) V) V {
+	hash := key.Hash()
+	if entry, exists := m.entries[hash]; exists {
+		value = fn(entry.Value, value)
+	}
+	m.entries[hash] = maps.Entry[K, V]{Key: key, Value: value}
+	return value
+}
+
+// Size returns the number of entries in the HashMap.
+func (m *HashMap[K, V]) Size() int {
+	return len(m.entries)
+}
+
+// IsEmpty checks if the HashMap has no entries.
+func (m *HashMap[K, V]) IsEmpty() bool {
+	return len(m.entries) == 0
+}
+
+// Clear removes all entries from the HashMap.
+func (m *HashMap[K, V]) Clear() {
+	m.entries = make(map[string]maps.Entry[K, V])
+}
+
+// Keys returns a slice containing all keys in the HashMap.
+func (m *HashMap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(m.entries))
+	for _, entry := range m.entries {
+		keys = append(keys, entry.Key)
+	}
+	return keys
+}
+
+// Values returns a slice containing all values in the HashMap.
+func (m *HashMap[K, V]) Values() []V {
+	values := make([]V, 0, len(m.entries))
+	for _, entry := range m.entries {
+		values = append(values, entry.Value)
+	}
+	return values
+}
+
+// Entries returns a slice containing all key-value pairs in the HashMap.
+func (m *HashMap[K, V]) Entries() []maps.Entry[K, V] {
+	entries := make([]maps.Entry[K, V], 0, len(m.entries))
+	for _, entry := range m.entries {
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// KeySet returns a live set view of the keys in the HashMap.
+func (m *HashMap[K, V]) KeySet() set.Set[K] {
+	return &keySet[K, V]{m: m, name: "HashMap.KeySet"}
+}
+
+// ToString returns a string representation of the HashMap.
+func (m *HashMap[K, V]) ToString() string {
+	var sb strings.Builder
+	sb.WriteString("HashMap{")
+	writeEntries(&sb, m.entries)
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// writeEntries writes entries as comma separated hash=value pairs.
+func writeEntries[K set.Setable, V any](sb *strings.Builder, entries map[string]maps.Entry[K, V]) {
+	first := true
+	for hash, entry := range entries {
+		if !first {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(hash)
+		sb.WriteString("=")
+		sb.WriteString(fmt.Sprintf("%v", entry.Value))
+		first = false
+	}
+}
@@ -0,0 +1,108 @@
+package hashmap_test
+
+import (
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps/hashmap"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashMap_BasicOperations(t *testing.T) {
+	var hashMap maps.Map[*mocks.MockSetable, int] = hashmap.NewHashMap[*mocks.MockSetable, int]()
+	key1 := mocks.NewMockSetable("key1")
+	key2 := mocks.NewMockSetable("key2")
+
+	// Put and Get
+	hashMap.Put(key1, 1)
+	hashMap.Put(key2, 2)
+	hashMap.Put(mocks.NewMockSetable("key1"), 10)
+	assert.Equal(t, 2, hashMap.Size())
+	value, ok := hashMap.Get(key1)
+	assert.True(t, ok)
+	assert.Equal(t, 10, value)
+
+	// Delete
+	hashMap.Delete(key1)
+	assert.False(t, hashMap.ContainsKey(key1))
+	assert.True(t, hashMap.ContainsKey(key2))
+	_, ok = hashMap.Get(key1)
+	assert.False(t, ok)
+
+	// Clear
+	hashMap.Clear()
+	assert.True(t, hashMap.IsEmpty())
+}
+
+func TestHashMap_KeysValuesEntries(t *testing.T) {
+	hashMap := hashmap.NewHashMap[*mocks.MockSetable, string]()
+	key1 := mocks.NewMockSetable("key1")
+	key2 := mocks.NewMockSetable("key2")
+	hashMap.Put(key1, "one")
+	hashMap.Put(key2, "two")
+
+	assert.ElementsMatch(t, []*mocks.MockSetable{key1, key2}, hashMap.Keys())
+	assert.ElementsMatch(t, []string{"one", "two"}, hashMap.Values())
+	assert.ElementsMatch(t, []maps.Entry[*mocks.MockSetable, string]{
+		{Key: key1, Value: "one"},
+		{Key: key2, Value: "two"},
+	}, hashMap.Entries())
+
+	str := hashMap.ToString()
+	assert.Contains(t, str, "key1=one")
+	assert.Contains(t, str, "key2=two")
+}
+
+func TestHashMap_ComputeIfAbsent(t *testing.T) {
+	hashMap := hashmap.NewHashMap[*mocks.MockSetable, int]()
+	key := mocks.NewMockSetable("key")
+	calls := 0
+	compute := func(*mocks.MockSetable) int {
+		calls++
+		return 42
+	}
+
+	assert.Equal(t, 42, hashMap.ComputeIfAbsent(key, compute))
+	assert.Equal(t, 42, hashMap.ComputeIfAbsent(key, compute))
+	assert.Equal(t, 1, calls)
+}
+
+func TestHashMap_Merge(t *testing.T) {
+	hashMap := hashmap.NewHashMap[*mocks.MockSetable, int]()
+	key := mocks.NewMockSetable("key")
+	sum := func(existing, value int) int { return existing + value }
+
+	assert.Equal(t, 1, hashMap.Merge(key, 1, sum))
+	assert.Equal(t, 3, hashMap.Merge(key, 2, sum))
+	value, _ := hashMap.Get(key)
+	assert.Equal(t, 3, value)
+}
+
+func TestHashMap_KeySet(t *testing.T) {
+	hashMap := hashmap.NewHashMap[*mocks.MockSetable, int]()
+	key1 := mocks.NewMockSetable("key1")
+	key2 := mocks.NewMockSetable("key2")
+	hashMap.Put(key1, 1)
+
+	var keys set.Set[*mocks.MockSetable] = hashMap.KeySet()
+	assert.True(t, keys.Contains(key1))
+	assert.Equal(t, 1, keys.Size())
+
+	// Changes through the view reach the map
+	keys.Add(key1, key2)
+	value, ok := hashMap.Get(key1)
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+	assert.True(t, hashMap.ContainsKey(key2))
+
+	keys.Remove(key1)
+	assert.False(t, hashMap.ContainsKey(key1))
+
+	// Changes to the map are visible in the view
+	hashMap.Delete(key2)
+	assert.True(t, keys.IsEmpty())
+	assert.Empty(t, keys.ToSlice())
+	assert.Equal(t, "HashMap.KeySet{}", keys.ToString())
+}
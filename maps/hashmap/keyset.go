@@ -0,0 +1,82 @@
+package hashmap
+
+import (
+	"strings"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set"
+)
+
+// backingMap is the part of a hash map the key set view relies on.
+type backingMap[K set.Setable, V any] interface {
+	maps.Map[K, V]
+	ComputeIfAbsent(key K, fn func(key K) V) V
+}
+
+// keySet is a live set view over the keys of a map.
+// Changes to the map are visible in the view and vice versa.
+type keySet[K set.Setable, V any] struct {
+	m    backingMap[K, V]
+	name string
+}
+
+// Add inserts keys that are not yet in the map, associated with the
+// zero value of V. Keys already in the map keep their value.
+func (s *keySet[K, V]) Add(values ...K) {
+	for _, value := range values {
+		s.m.ComputeIfAbsent(value, func(K) V {
+			var zero V
+			return zero
+		})
+	}
+}
+
+// Remove deletes the keys, and their values, from the map.
+func (s *keySet[K, V]) Remove(values ...K) {
+	s.m.Delete(values...)
+}
+
+// Contains checks if all specified keys are in the map.
+func (s *keySet[K, V]) Contains(values ...K) bool {
+	for _, value := range values {
+		if !s.m.ContainsKey(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Size returns the number of keys in the map.
+func (s *keySet[K, V]) Size() int {
+	return s.m.Size()
+}
+
+// IsEmpty checks if the map has no keys.
+func (s *keySet[K, V]) IsEmpty() bool {
+	return s.m.IsEmpty()
+}
+
+// Clear removes all entries from the map.
+func (s *keySet[K, V]) Clear() {
+	s.m.Clear()
+}
+
+// ToString returns a string representation of the key set.
+func (s *keySet[K, V]) ToString() string {
+	var sb strings.Builder
+	sb.WriteString(s.name)
+	sb.WriteString("{")
+	for i, key := range s.m.Keys() {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(key.Hash())
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// ToSlice returns a slice containing all keys in the map.
+func (s *keySet[K, V]) ToSlice() []K {
+	return s.m.Keys()
+}
@@ -0,0 +1,161 @@
+package hashmap
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set"
+)
+
+// SyncHashMap is a thread-safe implementation of the HashMap.
+// It uses a read-write mutex to allow concurrent access.
+type SyncHashMap[K set.Setable, V any] struct {
+	entries map[string]maps.Entry[K, V]
+	mu      sync.RWMutex
+}
+
+// NewSyncHashMap creates and returns a new instance of SyncHashMap.
+func NewSyncHashMap[K set.Setable, V any]() *SyncHashMap[K, V] {
+	return &SyncHashMap[K, V]{
+		entries: make(map[string]maps.Entry[K, V]),
+	}
+}
+
+// Put associates value with key, replacing any previous value.
+func (m *SyncHashMap[K, V]) Put(key K, value V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[key.Hash()] = maps.Entry[K, V]{Key: key, Value: value}
+}
+
+// Get returns the value associated with key and whether it was found.
+func (m *SyncHashMap[K, V]) Get(key K) (V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	entry, exists := m.entries[key.Hash()]
+	return entry.Value, exists
+}
+
+// Delete removes one or more keys from the SyncHashMap.
+func (m *SyncHashMap[K, V]) Delete(keys ...K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, key := range keys {
+		delete(m.entries, key.Hash())
+	}
+}
+
+// ContainsKey checks if the key is present in the SyncHashMap.
+func (m *SyncHashMap[K, V]) ContainsKey(key K) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	_, exists := m.entries[key.Hash()]
+	return exists
+}
+
+// ComputeIfAbsent returns the value associated with key. If the key is
+// absent, fn is called to produce a value which is stored and returned.
+// The check and the insert happen atomically; fn runs under the write
+// lock and must not call back into the map.
+func (m *SyncHashMap[K, V]) ComputeIfAbsent(key K, fn func(key K) V) V {
+	hash := key.Hash()
+	m.mu.RLock()
+	entry, exists := m.entries[hash]
+	m.mu.RUnlock()
+	if exists {
+		return entry.Value
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if entry, exists := m.entries[hash]; exists {
+		return entry.Value
+	}
+	value := fn(key)
+	m.entries[hash] = maps.Entry[K, V]{Key: key, Value: value}
+	return value
+}
+
+// Merge stores value under key if the key is absent, otherwise it stores
+// the result of fn applied to the existing value and value.
+// fn runs under the write lock and must not call back into the map.
+func (m *SyncHashMap[K, V]) Merge(key K, value V, fn func(existing, value V) V) V {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	hash := key.Hash()
+	if entry, exists := m.entries[hash]; exists {
+		value = fn(entry.Value, value)
+	}
+	m.entries[hash] = maps.Entry[K, V]{Key: key, Value: value}
+	return value
+}
+
+// Size returns the number of entries in the SyncHashMap.
+func (m *SyncHashMap[K, V]) Size() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.entries)
+}
+
+// IsEmpty checks if the SyncHashMap is empty.
+func (m *SyncHashMap[K, V]) IsEmpty() bool {
+	return m.Size() == 0
+}
+
+// Clear removes all entries from the SyncHashMap.
+func (m *SyncHashMap[K, V]) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = make(map[string]maps.Entry[K, V])
+}
+
+// Keys returns a slice containing all keys in the SyncHashMap.
+func (m *SyncHashMap[K, V]) Keys() []K {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	keys := make([]K, 0, len(m.entries))
+	for _, entry := range m.entries {
+		keys = append(keys, entry.Key)
+	}
+	return keys
+}
+
+// Values returns a slice containing all values in the SyncHashMap.
+func (m *SyncHashMap[K, V]) Values() []V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	values := make([]V, 0, len(m.entries))
+	for _, entry := range m.entries {
+		values = append(values, entry.Value)
+	}
+	return values
+}
+
+// Entries returns a slice containing all key-value pairs in the SyncHashMap.
+func (m *SyncHashMap[K, V]) Entries() []maps.Entry[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	entries := make([]maps.Entry[K, V], 0, len(m.entries))
+	for _, entry := range m.entries {
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// KeySet returns a live set view of the keys in the SyncHashMap.
+// Every operation on the view takes the map's lock.
+func (m *SyncHashMap[K, V]) KeySet() set.Set[K] {
+	return &keySet[K, V]{m: m, name: "SyncHashMap.KeySet"}
+}
+
+// ToString returns a string representation of the SyncHashMap.
+func (m *SyncHashMap[K, V]) ToString() string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var sb strings.Builder
+	sb.WriteString("SyncHashMap{")
+	writeEntries(&sb, m.entries)
+	sb.WriteString("}")
+	return sb.String()
+}
@@ -0,0 +1,71 @@
+package hashmap_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps/hashmap"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncHashMap_BasicOperations(t *testing.T) {
+	syncHashMap := hashmap.NewSyncHashMap[*mocks.MockSetable, int]()
+	key1 := mocks.NewMockSetable("key1")
+	key2 := mocks.NewMockSetable("key2")
+
+	syncHashMap.Put(key1, 1)
+	syncHashMap.Put(key2, 2)
+	assert.Equal(t, 2, syncHashMap.Size())
+	assert.ElementsMatch(t, []int{1, 2}, syncHashMap.Values())
+	assert.Len(t, syncHashMap.Entries(), 2)
+
+	syncHashMap.Delete(key1)
+	assert.False(t, syncHashMap.ContainsKey(key1))
+	assert.Equal(t, []*mocks.MockSetable{key2}, syncHashMap.Keys())
+	assert.Equal(t, "SyncHashMap{key2=2}", syncHashMap.ToString())
+
+	syncHashMap.Clear()
+	assert.True(t, syncHashMap.IsEmpty())
+}
+
+func TestSyncHashMap_KeySet(t *testing.T) {
+	syncHashMap := hashmap.NewSyncHashMap[*mocks.MockSetable, int]()
+	key := mocks.NewMockSetable("key")
+	keys := syncHashMap.KeySet()
+
+	keys.Add(key)
+	value, ok := syncHashMap.Get(key)
+	assert.True(t, ok)
+	assert.Equal(t, 0, value)
+
+	keys.Clear()
+	assert.True(t, syncHashMap.IsEmpty())
+}
+
+func TestSyncHashMap_ConcurrentAccess(t *testing.T) {
+	syncHashMap := hashmap.NewSyncHashMap[*mocks.MockSetable, int]()
+	counter := mocks.NewMockSetable("counter")
+	sum := func(existing, value int) int { return existing + value }
+
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				syncHashMap.Merge(counter, 1, sum)
+				syncHashMap.ComputeIfAbsent(mocks.NewMockSetable(fmt.Sprintf("key%d", i)), func(*mocks.MockSetable) int {
+					return g
+				})
+				_, _ = syncHashMap.Get(counter)
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	value, _ := syncHashMap.Get(counter)
+	assert.Equal(t, 400, value)
+	assert.Equal(t, 101, syncHashMap.Size())
+}
@@ -0,0 +1,202 @@
+package linkedhashmap
+
+import (
+	"container/list"
+	"fmt"
+	"strings"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps"
+)
+
+// RemoveEldestFunc decides, after an insertion, whether the eldest entry
+// should be removed. size is the number of entries including the new one.
+type RemoveEldestFunc[K comparable, V any] func(eldest maps.Entry[K, V], size int) bool
+
+// LinkedHashMap maintains the insertion order of its entries, or the
+// access order when created with NewAccessOrder.
+type LinkedHashMap[K comparable, V any] struct {
+	data         map[K]*list.Element
+	order        *list.List
+	accessOrder  bool
+	removeEldest RemoveEldestFunc[K, V]
+}
+
+// New initializes a new LinkedHashMap ordered by insertion.
+// Re-inserting an existing key does not change its position.
+func New[K comparable, V any]() *LinkedHashMap[K, V] {
+	return &LinkedHashMap[K, V]{
+		data:  make(map[K]*list.Element),
+		order: list.New(),
+	}
+}
+
+// NewAccessOrder initializes a new LinkedHashMap ordered by access,
+// from least recently to most recently used. Put and Get move the
+// entry to the back.
+func NewAccessOrder[K comparable, V any]() *LinkedHashMap[K, V] {
+	m := New[K, V]()
+	m.accessOrder = true
+	return m
+}
+
+// SetRemoveEldest installs a policy that is consulted after every
+// insertion of a new key; when it returns true the eldest entry is removed.
+// Passing nil disables the policy.
+func (m *LinkedHashMap[K, V]) SetRemoveEldest(policy RemoveEldestFunc[K, V]) {
+	m.removeEldest = policy
+}
+
+// Put associates value with key.
+func (m *LinkedHashMap[K, V]) Put(key K, value V) {
+	if elem, exists := m.data[key]; exists {
+		elem.Value = maps.Entry[K, V]{Key: key, Value: value}
+		if m.accessOrder {
+			m.order.MoveToBack(elem)
+		}
+		return
+	}
+	m.data[key] = m.order.PushBack(maps.Entry[K, V]{Key: key, Value: value})
+	if m.removeEldest != nil {
+		eldest := m.order.Front()
+		if m.removeEldest(eldest.Value.(maps.Entry[K, V]), len(m.data)) {
+			m.removeElement(eldest)
+		}
+	}
+}
+
+// Get returns the value associated with key and whether it was found.
+// In access order mode the entry becomes the most recently used.
+func (m *LinkedHashMap[K, V]) Get(key K) (V, bool) {
+	elem, exists := m.data[key]
+	if !exists {
+		var zero V
+		return zero, false
+	}
+	if m.accessOrder {
+		m.order.MoveToBack(elem)
+	}
+	return elem.Value.(maps.Entry[K, V]).Value, true
+}
+
+// Delete removes one or more keys from the LinkedHashMap.
+func (m *LinkedHashMap[K, V]) Delete(keys ...K) {
+	for _, key := range keys {
+		if elem, exists := m.data[key]; exists {
+			m.removeElement(elem)
+		}
+	}
+}
+
+// ContainsKey checks if the key is present. It never changes the order.
+func (m *LinkedHashMap[K, V]) ContainsKey(key K) bool {
+	_, exists := m.data[key]
+	return exists
+}
+
+// MoveToBack moves the entry for key to the back of the iteration order.
+// It reports whether the key was present.
+func (m *LinkedHashMap[K, V]) MoveToBack(key K) bool {
+	elem, exists := m.data[key]
+	if exists {
+		m.order.MoveToBack(elem)
+	}
+	return exists
+}
+
+// FirstEntry returns the eldest entry, if any.
+func (m *LinkedHashMap[K, V]) FirstEntry() (maps.Entry[K, V], bool) {
+	return entryOf[K, V](m.order.Front())
+}
+
+// LastEntry returns the youngest entry, if any.
+func (m *LinkedHashMap[K, V]) LastEntry() (maps.Entry[K, V], bool) {
+	return entryOf[K, V](m.order.Back())
+}
+
+// Each calls fn for every entry in order until fn returns false.
+// fn must not modify the map.
+func (m *LinkedHashMap[K, V]) Each(fn func(key K, value V) bool) {
+	for elem := m.order.Front(); elem != nil; elem = elem.Next() {
+		entry := elem.Value.(maps.Entry[K, V])
+		if !fn(entry.Key, entry.Value) {
+			return
+		}
+	}
+}
+
+// Size returns the number of entries in the LinkedHashMap.
+func (m *LinkedHashMap[K, V]) Size() int {
+	return len(m.data)
+}
+
+// IsEmpty checks if the LinkedHashMap is empty.
+func (m *LinkedHashMap[K, V]) IsEmpty() bool {
+	return len(m.data) == 0
+}
+
+// Clear removes all entries from the LinkedHashMap.
+func (m *LinkedHashMap[K, V]) Clear() {
+	m.data = make(map[K]*list.Element)
+	m.order.Init()
+}
+
+// Keys returns the keys in order.
+func (m *LinkedHashMap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(m.data))
+	m.Each(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Values returns the values in order.
+func (m *LinkedHashMap[K, V]) Values() []V {
+	values := make([]V, 0, len(m.data))
+	m.Each(func(_ K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+// Entries returns the key-value pairs in order.
+func (m *LinkedHashMap[K, V]) Entries() []maps.Entry[K, V] {
+	entries := make([]maps.Entry[K, V], 0, len(m.data))
+	m.Each(func(key K, value V) bool {
+		entries = append(entries, maps.Entry[K, V]{Key: key, Value: value})
+		return true
+	})
+	return entries
+}
+
+// ToString returns a string representation of the LinkedHashMap.
+func (m *LinkedHashMap[K, V]) ToString() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	first := true
+	m.Each(func(key K, value V) bool {
+		if !first {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v=%v", key, value))
+		first = false
+		return true
+	})
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// removeElement unlinks elem and drops its key.
+func (m *LinkedHashMap[K, V]) removeElement(elem *list.Element) {
+	m.order.Remove(elem)
+	delete(m.data, elem.Value.(maps.Entry[K, V]).Key)
+}
+
+// entryOf returns the entry stored in elem, if elem is not nil.
+func entryOf[K comparable, V any](elem *list.Element) (maps.Entry[K, V], bool) {
+	if elem == nil {
+		return maps.Entry[K, V]{}, false
+	}
+	return elem.Value.(maps.Entry[K, V]), true
+}
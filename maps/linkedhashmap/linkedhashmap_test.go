@@ -0,0 +1,101 @@
+package linkedhashmap_test
+
+import (
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps/linkedhashmap"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinkedHashMap_InsertionOrder(t *testing.T) {
+	var m maps.Map[string, int] = linkedhashmap.New[string, int]()
+
+	m.Put("b", 2)
+	m.Put("a", 1)
+	m.Put("c", 3)
+	m.Put("b", 20)
+
+	assert.Equal(t, []string{"b", "a", "c"}, m.Keys())
+	assert.Equal(t, []int{20, 1, 3}, m.Values())
+	assert.Equal(t, "{b=20, a=1, c=3}", m.ToString())
+
+	m.Delete("a")
+	assert.Equal(t, []maps.Entry[string, int]{{Key: "b", Value: 20}, {Key: "c", Value: 3}}, m.Entries())
+	assert.False(t, m.ContainsKey("a"))
+
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+	assert.Equal(t, "{}", m.ToString())
+}
+
+func TestLinkedHashMap_AccessOrder(t *testing.T) {
+	m := linkedhashmap.NewAccessOrder[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+
+	_, _ = m.Get("a")
+	assert.Equal(t, []string{"b", "c", "a"}, m.Keys())
+
+	m.Put("b", 20)
+	assert.Equal(t, []string{"c", "a", "b"}, m.Keys())
+
+	// ContainsKey does not count as an access
+	m.ContainsKey("c")
+	assert.Equal(t, []string{"c", "a", "b"}, m.Keys())
+}
+
+func TestLinkedHashMap_FirstLastEntry(t *testing.T) {
+	m := linkedhashmap.New[string, int]()
+	_, ok := m.FirstEntry()
+	assert.False(t, ok)
+	_, ok = m.LastEntry()
+	assert.False(t, ok)
+
+	m.Put("a", 1)
+	m.Put("b", 2)
+	first, _ := m.FirstEntry()
+	last, _ := m.LastEntry()
+	assert.Equal(t, maps.Entry[string, int]{Key: "a", Value: 1}, first)
+	assert.Equal(t, maps.Entry[string, int]{Key: "b", Value: 2}, last)
+
+	assert.True(t, m.MoveToBack("a"))
+	assert.False(t, m.MoveToBack("missing"))
+	first, _ = m.FirstEntry()
+	assert.Equal(t, "b", first.Key)
+}
+
+func TestLinkedHashMap_RemoveEldest(t *testing.T) {
+	m := linkedhashmap.NewAccessOrder[int, string]()
+	var evicted []int
+	m.SetRemoveEldest(func(eldest maps.Entry[int, string], size int) bool {
+		if size > 2 {
+			evicted = append(evicted, eldest.Key)
+			return true
+		}
+		return false
+	})
+
+	m.Put(1, "one")
+	m.Put(2, "two")
+	_, _ = m.Get(1)
+	m.Put(3, "three")
+
+	assert.Equal(t, []int{2}, evicted)
+	assert.Equal(t, []int{1, 3}, m.Keys())
+}
+
+func TestLinkedHashMap_Each(t *testing.T) {
+	m := linkedhashmap.New[int, int]()
+	for i := 1; i <= 5; i++ {
+		m.Put(i, i*i)
+	}
+
+	var visited []int
+	m.Each(func(key, value int) bool {
+		visited = append(visited, value)
+		return key < 3
+	})
+	assert.Equal(t, []int{1, 4, 9}, visited)
+}
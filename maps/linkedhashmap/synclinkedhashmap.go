@@ -0,0 +1,142 @@
+package linkedhashmap
+
+import (
+	"sync"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps"
+)
+
+// SyncLinkedHashMap is a thread-safe version of LinkedHashMap.
+// Because Get reorders entries in access order mode, every operation
+// that may touch the order takes the write lock.
+type SyncLinkedHashMap[K comparable, V any] struct {
+	m  *LinkedHashMap[K, V]
+	mu sync.RWMutex
+}
+
+// NewSync initializes a new SyncLinkedHashMap ordered by insertion.
+func NewSync[K comparable, V any]() *SyncLinkedHashMap[K, V] {
+	return &SyncLinkedHashMap[K, V]{m: New[K, V]()}
+}
+
+// NewSyncAccessOrder initializes a new SyncLinkedHashMap ordered by access.
+func NewSyncAccessOrder[K comparable, V any]() *SyncLinkedHashMap[K, V] {
+	return &SyncLinkedHashMap[K, V]{m: NewAccessOrder[K, V]()}
+}
+
+// SetRemoveEldest installs the eviction policy consulted after insertions.
+// The policy runs under the lock and must not call back into the map.
+func (s *SyncLinkedHashMap[K, V]) SetRemoveEldest(policy RemoveEldestFunc[K, V]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.SetRemoveEldest(policy)
+}
+
+// Put associates value with key.
+func (s *SyncLinkedHashMap[K, V]) Put(key K, value V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.Put(key, value)
+}
+
+// Get returns the value associated with key and whether it was found.
+func (s *SyncLinkedHashMap[K, V]) Get(key K) (V, bool) {
+	if !s.m.accessOrder {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		return s.m.Get(key)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.Get(key)
+}
+
+// Delete removes one or more keys from the SyncLinkedHashMap.
+func (s *SyncLinkedHashMap[K, V]) Delete(keys ...K) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.Delete(keys...)
+}
+
+// ContainsKey checks if the key is present.
+func (s *SyncLinkedHashMap[K, V]) ContainsKey(key K) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.ContainsKey(key)
+}
+
+// MoveToBack moves the entry for key to the back of the iteration order.
+func (s *SyncLinkedHashMap[K, V]) MoveToBack(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.m.MoveToBack(key)
+}
+
+// FirstEntry returns the eldest entry, if any.
+func (s *SyncLinkedHashMap[K, V]) FirstEntry() (maps.Entry[K, V], bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.FirstEntry()
+}
+
+// LastEntry returns the youngest entry, if any.
+func (s *SyncLinkedHashMap[K, V]) LastEntry() (maps.Entry[K, V], bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.LastEntry()
+}
+
+// Each calls fn for every entry in order until fn returns false.
+// fn runs under the read lock and must not call back into the map.
+func (s *SyncLinkedHashMap[K, V]) Each(fn func(key K, value V) bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s.m.Each(fn)
+}
+
+// Size returns the number of entries in the SyncLinkedHashMap.
+func (s *SyncLinkedHashMap[K, V]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Size()
+}
+
+// IsEmpty checks if the SyncLinkedHashMap is empty.
+func (s *SyncLinkedHashMap[K, V]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+// Clear removes all entries from the SyncLinkedHashMap.
+func (s *SyncLinkedHashMap[K, V]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m.Clear()
+}
+
+// Keys returns the keys in order.
+func (s *SyncLinkedHashMap[K, V]) Keys() []K {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Keys()
+}
+
+// Values returns the values in order.
+func (s *SyncLinkedHashMap[K, V]) Values() []V {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Values()
+}
+
+// Entries returns the key-value pairs in order.
+func (s *SyncLinkedHashMap[K, V]) Entries() []maps.Entry[K, V] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Entries()
+}
+
+// ToString returns a string representation of the SyncLinkedHashMap.
+func (s *SyncLinkedHashMap[K, V]) ToString() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.ToString()
+}
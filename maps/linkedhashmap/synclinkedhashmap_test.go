@@ -0,0 +1,58 @@
+package linkedhashmap_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps/linkedhashmap"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncLinkedHashMap_BasicOperations(t *testing.T) {
+	m := linkedhashmap.NewSync[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	value, ok := m.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+	assert.Equal(t, []string{"a", "b"}, m.Keys())
+	assert.Equal(t, []int{1, 2}, m.Values())
+	assert.Len(t, m.Entries(), 2)
+	assert.Equal(t, "{a=1, b=2}", m.ToString())
+
+	m.MoveToBack("a")
+	first, _ := m.FirstEntry()
+	last, _ := m.LastEntry()
+	assert.Equal(t, "b", first.Key)
+	assert.Equal(t, "a", last.Key)
+
+	m.Delete("a")
+	assert.False(t, m.ContainsKey("a"))
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+}
+
+func TestSyncLinkedHashMap_ConcurrentAccess(t *testing.T) {
+	m := linkedhashmap.NewSyncAccessOrder[int, int]()
+	m.SetRemoveEldest(func(_ maps.Entry[int, int], size int) bool {
+		return size > 10
+	})
+
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				m.Put(g*100+i, i)
+				_, _ = m.Get(g * 100)
+				m.Each(func(int, int) bool { return true })
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 10, m.Size())
+}
@@ -0,0 +1,45 @@
+package maps
+
+// Entry is a single key-value pair stored in a map.
+type Entry[K any, V any] struct {
+	Key   K
+	Value V
+}
+
+// Map defines the basic operations for a map data structure.
+// Implementations decide how keys are compared and in which order
+// Keys, Values and Entries are returned.
+type Map[K any, V any] interface {
+	// Put associates value with key, replacing any previous value.
+	Put(key K, value V)
+
+	// Get returns the value associated with key and whether it was found.
+	Get(key K) (V, bool)
+
+	// Delete removes one or more keys from the map.
+	Delete(keys ...K)
+
+	// ContainsKey checks if the key is present in the map.
+	ContainsKey(key K) bool
+
+	// Size returns the number of entries in the map.
+	Size() int
+
+	// IsEmpty checks if the map is empty.
+	IsEmpty() bool
+
+	// Clear removes all entries from the map.
+	Clear()
+
+	// Keys returns a slice containing all keys in the map.
+	Keys() []K
+
+	// Values returns a slice containing all values in the map.
+	Values() []V
+
+	// Entries returns a slice containing all key-value pairs in the map.
+	Entries() []Entry[K, V]
+
+	// ToString returns a string representation of the map.
+	ToString() string
+}
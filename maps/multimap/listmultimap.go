@@ -0,0 +1,232 @@
+package multimap
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ListMultiMap is a MultiMap whose values per key are kept in insertion
+// order, duplicates included.
+type ListMultiMap[K comparable, V comparable] struct {
+	buckets map[K][]V
+	size    int
+}
+
+// NewListMultiMap creates and returns a new instance of ListMultiMap.
+func NewListMultiMap[K comparable, V comparable]() *ListMultiMap[K, V] {
+	return &ListMultiMap[K, V]{
+		buckets: make(map[K][]V),
+	}
+}
+
+// Put appends value to the values of key. It always returns true.
+func (m *ListMultiMap[K, V]) Put(key K, value V) bool {
+	m.buckets[key] = append(m.buckets[key], value)
+	m.size++
+	return true
+}
+
+// PutAll appends every value to the values of key.
+func (m *ListMultiMap[K, V]) PutAll(key K, values ...V) {
+	if len(values) == 0 {
+		return
+	}
+	m.buckets[key] = append(m.buckets[key], values...)
+	m.size += len(values)
+}
+
+// RemoveValue removes the first occurrence of value from the values of key.
+func (m *ListMultiMap[K, V]) RemoveValue(key K, value V) bool {
+	index := indexOf(m.buckets[key], value)
+	if index < 0 {
+		return false
+	}
+	m.removeAt(key, index)
+	return true
+}
+
+// RemoveAll removes key together with all its values.
+func (m *ListMultiMap[K, V]) RemoveAll(key K) {
+	m.size -= len(m.buckets[key])
+	delete(m.buckets, key)
+}
+
+// Get returns a live list view of the values of key.
+func (m *ListMultiMap[K, V]) Get(key K) *ListView[K, V] {
+	return &ListView[K, V]{m: m, key: key}
+}
+
+// ContainsKey checks if the key has at least one value.
+func (m *ListMultiMap[K, V]) ContainsKey(key K) bool {
+	_, exists := m.buckets[key]
+	return exists
+}
+
+// ContainsEntry checks if value is one of the values of key.
+func (m *ListMultiMap[K, V]) ContainsEntry(key K, value V) bool {
+	return indexOf(m.buckets[key], value) >= 0
+}
+
+// KeysWithValue returns every key that has value among its values.
+func (m *ListMultiMap[K, V]) KeysWithValue(value V) []K {
+	keys := make([]K, 0)
+	for key, bucket := range m.buckets {
+		if indexOf(bucket, value) >= 0 {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// Keys returns the distinct keys.
+func (m *ListMultiMap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(m.buckets))
+	for key := range m.buckets {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// KeyCount returns the number of distinct keys.
+func (m *ListMultiMap[K, V]) KeyCount() int {
+	return len(m.buckets)
+}
+
+// Size returns the number of key-value pairs.
+func (m *ListMultiMap[K, V]) Size() int {
+	return m.size
+}
+
+// IsEmpty checks if the multimap has no pairs.
+func (m *ListMultiMap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Clear removes all pairs.
+func (m *ListMultiMap[K, V]) Clear() {
+	m.buckets = make(map[K][]V)
+	m.size = 0
+}
+
+// Each calls fn for every key-value pair until fn returns false.
+// Values of one key are visited in insertion order.
+// fn must not modify the multimap.
+func (m *ListMultiMap[K, V]) Each(fn func(key K, value V) bool) {
+	for key, bucket := range m.buckets {
+		for _, value := range bucket {
+			if !fn(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// ToString returns a string representation of the ListMultiMap.
+func (m *ListMultiMap[K, V]) ToString() string {
+	var sb strings.Builder
+	sb.WriteString("ListMultiMap{")
+	first := true
+	for key, bucket := range m.buckets {
+		if !first {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v=%v", key, bucket))
+		first = false
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// removeAt removes the value at index from the values of key.
+func (m *ListMultiMap[K, V]) removeAt(key K, index int) V {
+	bucket := m.buckets[key]
+	value := bucket[index]
+	bucket = append(bucket[:index], bucket[index+1:]...)
+	if len(bucket) == 0 {
+		delete(m.buckets, key)
+	} else {
+		m.buckets[key] = bucket
+	}
+	m.size--
+	return value
+}
+
+// ListView is a live list view of the values of one key.
+type ListView[K comparable, V comparable] struct {
+	m   *ListMultiMap[K, V]
+	key K
+}
+
+// Add appends one or more values under the key.
+func (v *ListView[K, V]) Add(values ...V) {
+	v.m.PutAll(v.key, values...)
+}
+
+// Remove removes the first occurrence of value and reports whether it was present.
+func (v *ListView[K, V]) Remove(value V) bool {
+	return v.m.RemoveValue(v.key, value)
+}
+
+// RemoveAt removes and returns the value at index.
+// It panics if index is out of range.
+func (v *ListView[K, V]) RemoveAt(index int) V {
+	if index < 0 || index >= v.Size() {
+		panic("multimap: index out of range")
+	}
+	return v.m.removeAt(v.key, index)
+}
+
+// Get returns the value at index. It panics if index is out of range.
+func (v *ListView[K, V]) Get(index int) V {
+	return v.m.buckets[v.key][index]
+}
+
+// IndexOf returns the index of the first occurrence of value, or -1.
+func (v *ListView[K, V]) IndexOf(value V) int {
+	return indexOf(v.m.buckets[v.key], value)
+}
+
+// Contains checks if all specified values belong to the key.
+func (v *ListView[K, V]) Contains(values ...V) bool {
+	for _, value := range values {
+		if v.IndexOf(value) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Size returns the number of values of the key.
+func (v *ListView[K, V]) Size() int {
+	return len(v.m.buckets[v.key])
+}
+
+// IsEmpty checks if the key has no values.
+func (v *ListView[K, V]) IsEmpty() bool {
+	return v.Size() == 0
+}
+
+// Clear removes the key together with all its values.
+func (v *ListView[K, V]) Clear() {
+	v.m.RemoveAll(v.key)
+}
+
+// ToSlice returns a copy of the values of the key.
+func (v *ListView[K, V]) ToSlice() []V {
+	return append([]V{}, v.m.buckets[v.key]...)
+}
+
+// ToString returns a string representation of the values of the key.
+func (v *ListView[K, V]) ToString() string {
+	return fmt.Sprintf("%v", v.ToSlice())
+}
+
+// indexOf returns the index of the first occurrence of value, or -1.
+func indexOf[V comparable](values []V, value V) int {
+	for i, candidate := range values {
+		if candidate == value {
+			return i
+		}
+	}
+	return -1
+}
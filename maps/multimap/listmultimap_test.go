@@ -0,0 +1,80 @@
+package multimap_test
+
+import (
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps/multimap"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListMultiMap_BasicOperations(t *testing.T) {
+	var m multimap.MultiMap[string, int] = multimap.NewListMultiMap[string, int]()
+
+	assert.True(t, m.Put("a", 1))
+	assert.True(t, m.Put("a", 1))
+	m.PutAll("a", 2)
+	m.PutAll("b", 2, 3)
+	assert.Equal(t, 5, m.Size())
+	assert.Equal(t, 2, m.KeyCount())
+	assert.ElementsMatch(t, []string{"a", "b"}, m.KeysWithValue(2))
+	assert.Empty(t, m.KeysWithValue(4))
+
+	assert.True(t, m.RemoveValue("a", 1))
+	assert.True(t, m.ContainsEntry("a", 1))
+	assert.False(t, m.RemoveValue("a", 7))
+	assert.Equal(t, 4, m.Size())
+
+	m.RemoveAll("b")
+	assert.False(t, m.ContainsKey("b"))
+	assert.Equal(t, []string{"a"}, m.Keys())
+	assert.Equal(t, "ListMultiMap{a=[1 2]}", m.ToString())
+
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+}
+
+func TestListMultiMap_GetView(t *testing.T) {
+	m := multimap.NewListMultiMap[string, int]()
+	view := m.Get("a")
+
+	view.Add(3, 1, 3)
+	assert.Equal(t, []int{3, 1, 3}, view.ToSlice())
+	assert.Equal(t, 3, m.Size())
+	assert.Equal(t, 1, view.Get(1))
+	assert.Equal(t, 0, view.IndexOf(3))
+	assert.True(t, view.Contains(1, 3))
+	assert.False(t, view.Contains(2))
+
+	m.Put("a", 4)
+	assert.Equal(t, 4, view.Size())
+	assert.Equal(t, "[3 1 3 4]", view.ToString())
+
+	assert.True(t, view.Remove(3))
+	assert.Equal(t, 1, view.RemoveAt(0))
+	assert.Equal(t, []int{3, 4}, view.ToSlice())
+	assert.Panics(t, func() { view.RemoveAt(5) })
+
+	view.Clear()
+	assert.True(t, view.IsEmpty())
+	assert.False(t, m.ContainsKey("a"))
+}
+
+func TestListMultiMap_Invert(t *testing.T) {
+	m := multimap.NewListMultiMap[string, int]()
+	m.PutAll("a", 1, 2, 2)
+	m.PutAll("b", 2)
+
+	inverted := multimap.Invert[string, int](m, multimap.NewListMultiMap[int, string]())
+	assert.Equal(t, 4, inverted.Size())
+	assert.ElementsMatch(t, []int{1, 2}, inverted.KeysWithValue("a"))
+	assert.Equal(t, 2, inverted.KeyCount())
+
+	count := 0
+	inverted.Each(func(key int, _ string) bool {
+		if key == 2 {
+			count++
+		}
+		return true
+	})
+	assert.Equal(t, 3, count)
+}
@@ -0,0 +1,59 @@
+package multimap
+
+// MultiMap defines the operations shared by maps that associate a key
+// with a collection of values. Size counts key-value pairs, not keys.
+type MultiMap[K any, V any] interface {
+	// Put adds value to the values of key and reports whether the
+	// multimap changed.
+	Put(key K, value V) bool
+
+	// PutAll adds every value to the values of key.
+	PutAll(key K, values ...V)
+
+	// RemoveValue removes one occurrence of value from the values of key
+	// and reports whether it was present. A key without values is dropped.
+	RemoveValue(key K, value V) bool
+
+	// RemoveAll removes key together with all its values.
+	RemoveAll(key K)
+
+	// ContainsKey checks if the key has at least one value.
+	ContainsKey(key K) bool
+
+	// ContainsEntry checks if value is one of the values of key.
+	ContainsEntry(key K, value V) bool
+
+	// KeysWithValue returns every key that has value among its values.
+	KeysWithValue(value V) []K
+
+	// Keys returns the distinct keys.
+	Keys() []K
+
+	// KeyCount returns the number of distinct keys.
+	KeyCount() int
+
+	// Size returns the number of key-value pairs.
+	Size() int
+
+	// IsEmpty checks if the multimap has no pairs.
+	IsEmpty() bool
+
+	// Clear removes all pairs.
+	Clear()
+
+	// Each calls fn for every key-value pair until fn returns false.
+	Each(fn func(key K, value V) bool)
+
+	// ToString returns a string representation of the multimap.
+	ToString() string
+}
+
+// Invert puts every pair of src into dst with key and value swapped and
+// returns dst. Whether duplicates survive is decided by dst.
+func Invert[K any, V any](src MultiMap[K, V], dst MultiMap[V, K]) MultiMap[V, K] {
+	src.Each(func(key K, value V) bool {
+		dst.Put(value, key)
+		return true
+	})
+	return dst
+}
@@ -0,0 +1,216 @@
+package multimap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set/hashset"
+)
+
+// SetMultiMap is a MultiMap whose values per key are kept in a HashSet,
+// so a value appears at most once under each key.
+type SetMultiMap[K comparable, V set.Setable] struct {
+	buckets map[K]*hashset.HashSet[V]
+	size    int
+}
+
+// NewSetMultiMap creates and returns a new instance of SetMultiMap.
+func NewSetMultiMap[K comparable, V set.Setable]() *SetMultiMap[K, V] {
+	return &SetMultiMap[K, V]{
+		buckets: make(map[K]*hashset.HashSet[V]),
+	}
+}
+
+// Put adds value to the values of key. It returns false if the value
+// was already there.
+func (m *SetMultiMap[K, V]) Put(key K, value V) bool {
+	bucket, exists := m.buckets[key]
+	if !exists {
+		bucket = hashset.NewHashSet[V]()
+		m.buckets[key] = bucket
+	} else if bucket.Contains(value) {
+		return false
+	}
+	bucket.Add(value)
+	m.size++
+	return true
+}
+
+// PutAll adds every value to the values of key.
+func (m *SetMultiMap[K, V]) PutAll(key K, values ...V) {
+	for _, value := range values {
+		m.Put(key, value)
+	}
+}
+
+// RemoveValue removes value from the values of key.
+func (m *SetMultiMap[K, V]) RemoveValue(key K, value V) bool {
+	bucket, exists := m.buckets[key]
+	if !exists || !bucket.Contains(value) {
+		return false
+	}
+	bucket.Remove(value)
+	m.size--
+	if bucket.IsEmpty() {
+		delete(m.buckets, key)
+	}
+	return true
+}
+
+// RemoveAll removes key together with all its values.
+func (m *SetMultiMap[K, V]) RemoveAll(key K) {
+	if bucket, exists := m.buckets[key]; exists {
+		m.size -= bucket.Size()
+		delete(m.buckets, key)
+	}
+}
+
+// Get returns a live set view of the values of key. Adding to the view
+// adds pairs to the multimap even if the key had no values before.
+func (m *SetMultiMap[K, V]) Get(key K) set.Set[V] {
+	return &setView[K, V]{m: m, key: key}
+}
+
+// ContainsKey checks if the key has at least one value.
+func (m *SetMultiMap[K, V]) ContainsKey(key K) bool {
+	_, exists := m.buckets[key]
+	return exists
+}
+
+// ContainsEntry checks if value is one of the values of key.
+func (m *SetMultiMap[K, V]) ContainsEntry(key K, value V) bool {
+	bucket, exists := m.buckets[key]
+	return exists && bucket.Contains(value)
+}
+
+// KeysWithValue returns every key that has value among its values.
+func (m *SetMultiMap[K, V]) KeysWithValue(value V) []K {
+	keys := make([]K, 0)
+	for key, bucket := range m.buckets {
+		if bucket.Contains(value) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// Keys returns the distinct keys.
+func (m *SetMultiMap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(m.buckets))
+	for key := range m.buckets {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// KeyCount returns the number of distinct keys.
+func (m *SetMultiMap[K, V]) KeyCount() int {
+	return len(m.buckets)
+}
+
+// Size returns the number of key-value pairs.
+func (m *SetMultiMap[K, V]) Size() int {
+	return m.size
+}
+
+// IsEmpty checks if the multimap has no pairs.
+func (m *SetMultiMap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Clear removes all pairs.
+func (m *SetMultiMap[K, V]) Clear() {
+	m.buckets = make(map[K]*hashset.HashSet[V])
+	m.size = 0
+}
+
+// Each calls fn for every key-value pair until fn returns false.
+// fn must not modify the multimap.
+func (m *SetMultiMap[K, V]) Each(fn func(key K, value V) bool) {
+	for key, bucket := range m.buckets {
+		for _, value := range bucket.ToSlice() {
+			if !fn(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// ToString returns a string representation of the SetMultiMap.
+func (m *SetMultiMap[K, V]) ToString() string {
+	var sb strings.Builder
+	sb.WriteString("SetMultiMap{")
+	first := true
+	for key, bucket := range m.buckets {
+		if !first {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v=", key))
+		sb.WriteString(bucket.ToString())
+		first = false
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// setView is a live set view of the values of one key.
+type setView[K comparable, V set.Setable] struct {
+	m   *SetMultiMap[K, V]
+	key K
+}
+
+// Add inserts one or more values under the key.
+func (v *setView[K, V]) Add(values ...V) {
+	v.m.PutAll(v.key, values...)
+}
+
+// Remove deletes one or more values from the key.
+func (v *setView[K, V]) Remove(values ...V) {
+	for _, value := range values {
+		v.m.RemoveValue(v.key, value)
+	}
+}
+
+// Contains checks if all specified values belong to the key.
+func (v *setView[K, V]) Contains(values ...V) bool {
+	bucket, exists := v.m.buckets[v.key]
+	if !exists {
+		return len(values) == 0
+	}
+	return bucket.Contains(values...)
+}
+
+// Size returns the number of values of the key.
+func (v *setView[K, V]) Size() int {
+	if bucket, exists := v.m.buckets[v.key]; exists {
+		return bucket.Size()
+	}
+	return 0
+}
+
+// IsEmpty checks if the key has no values.
+func (v *setView[K, V]) IsEmpty() bool {
+	return v.Size() == 0
+}
+
+// Clear removes the key together with all its values.
+func (v *setView[K, V]) Clear() {
+	v.m.RemoveAll(v.key)
+}
+
+// ToString returns a string representation of the values of the key.
+func (v *setView[K, V]) ToString() string {
+	if bucket, exists := v.m.buckets[v.key]; exists {
+		return bucket.ToString()
+	}
+	return "HashSet{}"
+}
+
+// ToSlice returns a slice containing the values of the key.
+func (v *setView[K, V]) ToSlice() []V {
+	if bucket, exists := v.m.buckets[v.key]; exists {
+		return bucket.ToSlice()
+	}
+	return []V{}
+}
@@ -0,0 +1,96 @@
+package multimap_test
+
+import (
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps/multimap"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetMultiMap_BasicOperations(t *testing.T) {
+	var m multimap.MultiMap[string, *mocks.MockSetable] = multimap.NewSetMultiMap[string, *mocks.MockSetable]()
+	item1 := mocks.NewMockSetable("item1")
+	item2 := mocks.NewMockSetable("item2")
+
+	assert.True(t, m.Put("a", item1))
+	assert.False(t, m.Put("a", mocks.NewMockSetable("item1")))
+	m.PutAll("a", item2)
+	m.PutAll("b", item2)
+	assert.Equal(t, 3, m.Size())
+	assert.Equal(t, 2, m.KeyCount())
+	assert.True(t, m.ContainsEntry("a", item2))
+	assert.ElementsMatch(t, []string{"a", "b"}, m.KeysWithValue(item2))
+
+	assert.True(t, m.RemoveValue("b", item2))
+	assert.False(t, m.RemoveValue("b", item2))
+	assert.False(t, m.ContainsKey("b"))
+	assert.Equal(t, []string{"a"}, m.Keys())
+
+	m.RemoveAll("a")
+	assert.True(t, m.IsEmpty())
+	assert.Equal(t, "SetMultiMap{}", m.ToString())
+}
+
+func TestSetMultiMap_GetView(t *testing.T) {
+	m := multimap.NewSetMultiMap[string, *mocks.MockSetable]()
+	item1 := mocks.NewMockSetable("item1")
+	item2 := mocks.NewMockSetable("item2")
+	view := m.Get("a")
+
+	assert.True(t, view.IsEmpty())
+	assert.Empty(t, view.ToSlice())
+
+	// Writes through the view reach the multimap
+	view.Add(item1, item2, item1)
+	assert.Equal(t, 2, m.Size())
+	assert.True(t, m.ContainsEntry("a", item1))
+
+	// Writes to the multimap are visible in the view
+	m.RemoveValue("a", item1)
+	assert.False(t, view.Contains(item1))
+	assert.Equal(t, 1, view.Size())
+	assert.Equal(t, "HashSet{item2}", view.ToString())
+
+	view.Clear()
+	assert.False(t, m.ContainsKey("a"))
+	assert.True(t, m.IsEmpty())
+}
+
+func TestSetMultiMap_Invert(t *testing.T) {
+	m := multimap.NewSetMultiMap[string, *mocks.MockSetable]()
+	item1 := mocks.NewMockSetable("item1")
+	item2 := mocks.NewMockSetable("item2")
+	m.PutAll("a", item1, item2)
+	m.PutAll("b", item1)
+
+	inverted := multimap.Invert[string, *mocks.MockSetable](m, multimap.NewListMultiMap[*mocks.MockSetable, string]())
+	assert.Equal(t, 3, inverted.Size())
+	assert.ElementsMatch(t, []*mocks.MockSetable{item1}, inverted.KeysWithValue("b"))
+	assert.True(t, inverted.ContainsEntry(item1, "a"))
+	assert.True(t, inverted.ContainsEntry(item1, "b"))
+	assert.True(t, inverted.ContainsEntry(item2, "a"))
+}
+
+func TestSetMultiMap_Each(t *testing.T) {
+	m := multimap.NewSetMultiMap[int, *mocks.MockSetable]()
+	m.PutAll(1, mocks.NewMockSetable("x"), mocks.NewMockSetable("y"))
+	m.Put(2, mocks.NewMockSetable("z"))
+
+	count := 0
+	m.Each(func(int, *mocks.MockSetable) bool {
+		count++
+		return true
+	})
+	assert.Equal(t, 3, count)
+
+	count = 0
+	m.Each(func(int, *mocks.MockSetable) bool {
+		count++
+		return false
+	})
+	assert.Equal(t, 1, count)
+
+	m.Clear()
+	assert.Equal(t, 0, m.KeyCount())
+}
@@ -0,0 +1,367 @@
+package skiplist
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps"
+)
+
+const (
+	// maxLevel bounds the height of a node. With the default probability it
+	// keeps searches logarithmic well beyond 2^32 entries.
+	maxLevel = 32
+
+	defaultProbability = 0.25
+)
+
+type node[K any, V any] struct {
+	key   K
+	value V
+	next  []*node[K, V]
+}
+
+// SkipList is a sorted map built on a skip list. Each node is promoted to
+// the next level with a fixed probability, which gives expected O(log n)
+// searches and updates without any rebalancing.
+type SkipList[K any, V any] struct {
+	head        *node[K, V]
+	level       int
+	size        int
+	compare     func(a, b K) int
+	probability float64
+	rng         *rand.Rand
+}
+
+// New creates an empty SkipList ordered by compare, with a level
+// probability of 1/4 and a random seed.
+func New[K any, V any](compare func(a, b K) int) *SkipList[K, V] {
+	return NewWithProbability[K, V](compare, defaultProbability, rand.Int63())
+}
+
+// NewOrdered creates an empty SkipList using the natural order of K.
+func NewOrdered[K cmp.Ordered, V any]() *SkipList[K, V] {
+	return New[K, V](cmp.Compare[K])
+}
+
+// NewWithProbability creates an empty SkipList ordered by compare, in
+// which a node reaches each next level with the given probability. The
+// seed makes the node levels, and so the shape of the list, reproducible.
+// It panics if probability is not between 0 and 1 exclusive.
+func NewWithProbability[K any, V any](compare func(a, b K) int, probability float64, seed int64) *SkipList[K, V] {
+	if !(probability > 0 && probability < 1) {
+		panic(fmt.Sprintf("skiplist: invalid probability %v", probability))
+	}
+	return &SkipList[K, V]{
+		head:        &node[K, V]{next: make([]*node[K, V], maxLevel)},
+		level:       1,
+		compare:     compare,
+		probability: probability,
+		rng:         rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Comparator returns the function that orders the keys.
+func (s *SkipList[K, V]) Comparator() func(a, b K) int {
+	return s.compare
+}
+
+// Level returns the number of levels in use, which is the height of the
+// tallest node.
+func (s *SkipList[K, V]) Level() int {
+	return s.level
+}
+
+// Put associates value with key.
+func (s *SkipList[K, V]) Put(key K, value V) {
+	var update [maxLevel]*node[K, V]
+	if n := s.search(key, &update); n != nil {
+		n.value = value
+		return
+	}
+	level := s.randomLevel()
+	if level > s.level {
+		for i := s.level; i < level; i++ {
+			update[i] = s.head
+		}
+		s.level = level
+	}
+	n := &node[K, V]{key: key, value: value, next: make([]*node[K, V], level)}
+	for i := 0; i < level; i++ {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+	}
+	s.size++
+}
+
+// Get returns the value associated with key and whether it was found.
+func (s *SkipList[K, V]) Get(key K) (V, bool) {
+	if n := s.find(key); n != nil {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Delete removes one or more keys from the map.
+func (s *SkipList[K, V]) Delete(keys ...K) {
+	for _, key := range keys {
+		s.remove(key)
+	}
+}
+
+// ContainsKey checks if the key is present in the map.
+func (s *SkipList[K, V]) ContainsKey(key K) bool {
+	return s.find(key) != nil
+}
+
+// Size returns the number of entries in the map.
+func (s *SkipList[K, V]) Size() int {
+	return s.size
+}
+
+// IsEmpty checks if the map has no entries.
+func (s *SkipList[K, V]) IsEmpty() bool {
+	return s.size == 0
+}
+
+// Clear removes all entries from the map.
+func (s *SkipList[K, V]) Clear() {
+	clear(s.head.next)
+	s.level = 1
+	s.size = 0
+}
+
+// Each calls fn for every entry in key order until fn returns false.
+// fn must not modify the map.
+func (s *SkipList[K, V]) Each(fn func(key K, value V) bool) {
+	for n := s.head.next[0]; n != nil; n = n.next[0] {
+		if !fn(n.key, n.value) {
+			return
+		}
+	}
+}
+
+// Range calls fn in key order for every entry with from <= key < to
+// until fn returns false. fn must not modify the map.
+func (s *SkipList[K, V]) Range(from, to K, fn func(key K, value V) bool) {
+	for n := s.ceiling(from, true); n != nil && s.compare(n.key, to) < 0; n = n.next[0] {
+		if !fn(n.key, n.value) {
+			return
+		}
+	}
+}
+
+// Keys returns the keys in order.
+func (s *SkipList[K, V]) Keys() []K {
+	keys := make([]K, 0, s.size)
+	for n := s.head.next[0]; n != nil; n = n.next[0] {
+		keys = append(keys, n.key)
+	}
+	return keys
+}
+
+// Values returns the values in key order.
+func (s *SkipList[K, V]) Values() []V {
+	values := make([]V, 0, s.size)
+	for n := s.head.next[0]; n != nil; n = n.next[0] {
+		values = append(values, n.value)
+	}
+	return values
+}
+
+// Entries returns the key-value pairs in key order.
+func (s *SkipList[K, V]) Entries() []maps.Entry[K, V] {
+	entries := make([]maps.Entry[K, V], 0, s.size)
+	for n := s.head.next[0]; n != nil; n = n.next[0] {
+		entries = append(entries, maps.Entry[K, V]{Key: n.key, Value: n.value})
+	}
+	return entries
+}
+
+// FirstKey returns the lowest key.
+func (s *SkipList[K, V]) FirstKey() (K, bool) {
+	return keyOf(s.head.next[0])
+}
+
+// LastKey returns the highest key.
+func (s *SkipList[K, V]) LastKey() (K, bool) {
+	return keyOf(s.last())
+}
+
+// FirstEntry returns the entry with the lowest key.
+func (s *SkipList[K, V]) FirstEntry() (maps.Entry[K, V], bool) {
+	return entryOf(s.head.next[0])
+}
+
+// LastEntry returns the entry with the highest key.
+func (s *SkipList[K, V]) LastEntry() (maps.Entry[K, V], bool) {
+	return entryOf(s.last())
+}
+
+// PollFirst removes and returns the entry with the lowest key.
+func (s *SkipList[K, V]) PollFirst() (maps.Entry[K, V], bool) {
+	return s.poll(s.head.next[0])
+}
+
+// PollLast removes and returns the entry with the highest key.
+func (s *SkipList[K, V]) PollLast() (maps.Entry[K, V], bool) {
+	return s.poll(s.last())
+}
+
+// FloorKey returns the greatest key less than or equal to key.
+func (s *SkipList[K, V]) FloorKey(key K) (K, bool) {
+	return keyOf(s.floor(key, true))
+}
+
+// CeilingKey returns the least key greater than or equal to key.
+func (s *SkipList[K, V]) CeilingKey(key K) (K, bool) {
+	return keyOf(s.ceiling(key, true))
+}
+
+// LowerKey returns the greatest key strictly less than key.
+func (s *SkipList[K, V]) LowerKey(key K) (K, bool) {
+	return keyOf(s.floor(key, false))
+}
+
+// HigherKey returns the least key strictly greater than key.
+func (s *SkipList[K, V]) HigherKey(key K) (K, bool) {
+	return keyOf(s.ceiling(key, false))
+}
+
+// ToString returns a string representation of the map.
+func (s *SkipList[K, V]) ToString() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	for n := s.head.next[0]; n != nil; n = n.next[0] {
+		if n != s.head.next[0] {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v=%v", n.key, n.value))
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// randomLevel draws the height of a new node.
+func (s *SkipList[K, V]) randomLevel() int {
+	level := 1
+	for level < maxLevel && s.rng.Float64() < s.probability {
+		level++
+	}
+	return level
+}
+
+// search returns the node holding key, or nil, and fills update with the
+// last node before key on every level in use.
+func (s *SkipList[K, V]) search(key K, update *[maxLevel]*node[K, V]) *node[K, V] {
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && s.compare(x.next[i].key, key) < 0 {
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+	if n := x.next[0]; n != nil && s.compare(n.key, key) == 0 {
+		return n
+	}
+	return nil
+}
+
+func (s *SkipList[K, V]) find(key K) *node[K, V] {
+	n := s.ceiling(key, true)
+	if n != nil && s.compare(n.key, key) == 0 {
+		return n
+	}
+	return nil
+}
+
+// remove unlinks key and reports whether it was present.
+func (s *SkipList[K, V]) remove(key K) bool {
+	var update [maxLevel]*node[K, V]
+	n := s.search(key, &update)
+	if n == nil {
+		return false
+	}
+	for i := range n.next {
+		update[i].next[i] = n.next[i]
+	}
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+	s.size--
+	return true
+}
+
+func (s *SkipList[K, V]) poll(n *node[K, V]) (maps.Entry[K, V], bool) {
+	if n == nil {
+		return maps.Entry[K, V]{}, false
+	}
+	s.remove(n.key)
+	return maps.Entry[K, V]{Key: n.key, Value: n.value}, true
+}
+
+func (s *SkipList[K, V]) last() *node[K, V] {
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil {
+			x = x.next[i]
+		}
+	}
+	if x == s.head {
+		return nil
+	}
+	return x
+}
+
+// floor returns the greatest node with a key less than key, or equal to
+// it when inclusive is set.
+func (s *SkipList[K, V]) floor(key K, inclusive bool) *node[K, V] {
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil {
+			c := s.compare(x.next[i].key, key)
+			if c > 0 || (c == 0 && !inclusive) {
+				break
+			}
+			x = x.next[i]
+		}
+	}
+	if x == s.head {
+		return nil
+	}
+	return x
+}
+
+// ceiling returns the least node with a key greater than key, or equal to
+// it when inclusive is set.
+func (s *SkipList[K, V]) ceiling(key K, inclusive bool) *node[K, V] {
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil {
+			c := s.compare(x.next[i].key, key)
+			if c > 0 || (c == 0 && inclusive) {
+				break
+			}
+			x = x.next[i]
+		}
+	}
+	return x.next[0]
+}
+
+func keyOf[K any, V any](n *node[K, V]) (K, bool) {
+	if n == nil {
+		var zero K
+		return zero, false
+	}
+	return n.key, true
+}
+
+func entryOf[K any, V any](n *node[K, V]) (maps.Entry[K, V], bool) {
+	if n == nil {
+		return maps.Entry[K, V]{}, false
+	}
+	return maps.Entry[K, V]{Key: n.key, Value: n.value}, true
+}
@@ -0,0 +1,139 @@
+package skiplist_test
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps/skiplist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSkipList_BasicOperations(t *testing.T) {
+	var m maps.Map[int, string] = skiplist.NewOrdered[int, string]()
+	m.Put(5, "f")
+	m.Put(1, "b")
+	m.Put(3, "d")
+
+	assert.Equal(t, 3, m.Size())
+	assert.Equal(t, []int{1, 3, 5}, m.Keys())
+	assert.Equal(t, []string{"b", "d", "f"}, m.Values())
+	assert.Equal(t, "{1=b, 3=d, 5=f}", m.ToString())
+
+	m.Put(3, "x")
+	value, ok := m.Get(3)
+	assert.True(t, ok)
+	assert.Equal(t, "x", value)
+	assert.Equal(t, 3, m.Size())
+
+	m.Delete(1, 7)
+	assert.False(t, m.ContainsKey(1))
+	assert.Equal(t, []maps.Entry[int, string]{{Key: 3, Value: "x"}, {Key: 5, Value: "f"}}, m.Entries())
+
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+	assert.Equal(t, "{}", m.ToString())
+}
+
+func TestSkipList_Navigation(t *testing.T) {
+	s := skiplist.New[string, int](strings.Compare)
+	_, ok := s.FirstKey()
+	assert.False(t, ok)
+	for i, key := range []string{"d", "b", "f", "h"} {
+		s.Put(key, i)
+	}
+
+	first, _ := s.FirstKey()
+	last, _ := s.LastKey()
+	assert.Equal(t, "b", first)
+	assert.Equal(t, "h", last)
+
+	key, _ := s.FloorKey("e")
+	assert.Equal(t, "d", key)
+	key, _ = s.FloorKey("d")
+	assert.Equal(t, "d", key)
+	key, _ = s.LowerKey("d")
+	assert.Equal(t, "b", key)
+	_, ok = s.LowerKey("b")
+	assert.False(t, ok)
+	key, _ = s.CeilingKey("e")
+	assert.Equal(t, "f", key)
+	key, _ = s.HigherKey("f")
+	assert.Equal(t, "h", key)
+	_, ok = s.HigherKey("h")
+	assert.False(t, ok)
+
+	var keys []string
+	s.Range("c", "h", func(key string, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	assert.Equal(t, []string{"d", "f"}, keys)
+
+	entry, _ := s.PollFirst()
+	assert.Equal(t, maps.Entry[string, int]{Key: "b", Value: 1}, entry)
+	entry, _ = s.PollLast()
+	assert.Equal(t, maps.Entry[string, int]{Key: "h", Value: 3}, entry)
+	assert.Equal(t, []string{"d", "f"}, s.Keys())
+}
+
+func TestSkipList_SeedIsDeterministic(t *testing.T) {
+	levels := func(seed int64, probability float64) []int {
+		s := skiplist.NewWithProbability[int, int](func(a, b int) int { return a - b }, probability, seed)
+		var levels []int
+		for i := 0; i < 1000; i++ {
+			s.Put(i, i)
+			levels = append(levels, s.Level())
+		}
+		return levels
+	}
+	assert.Equal(t, levels(7, 0.5), levels(7, 0.5))
+
+	// A lower probability gives a flatter list
+	high := levels(7, 0.5)
+	low := levels(7, 0.05)
+	assert.Less(t, low[len(low)-1], high[len(high)-1])
+
+	assert.Panics(t, func() { skiplist.NewWithProbability[int, int](nil, 1, 0) })
+	assert.Panics(t, func() { skiplist.NewWithProbability[int, int](nil, 0, 0) })
+}
+
+func TestSkipList_RandomOperations(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	s := skiplist.NewWithProbability[int, int](func(a, b int) int { return a - b }, 0.5, 1)
+	reference := map[int]int{}
+
+	for i := 0; i < 5000; i++ {
+		key := rng.Intn(500)
+		if rng.Intn(3) == 0 {
+			s.Delete(key)
+			delete(reference, key)
+		} else {
+			s.Put(key, i)
+			reference[key] = i
+		}
+	}
+
+	keys := make([]int, 0, len(reference))
+	for key := range reference {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	assert.Equal(t, keys, s.Keys())
+	assert.Equal(t, len(reference), s.Size())
+	s.Each(func(key, value int) bool {
+		assert.Equal(t, reference[key], value)
+		return true
+	})
+	for key := -1; key <= 501; key++ {
+		floor, ok := s.FloorKey(key)
+		i, _ := slices.BinarySearch(keys, key+1)
+		if i == 0 {
+			assert.False(t, ok)
+		} else {
+			assert.Equal(t, keys[i-1], floor)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package treemap
+
+import (
+	"fmt"
+	"strings"
+)
+
+// KeySet is a live sorted set view over the keys of a TreeMap.
+// When K implements set.Setable it satisfies set.Set and set.SortedSet.
+type KeySet[K any, V any] struct {
+	m *TreeMap[K, V]
+}
+
+// Add inserts keys that are not yet in the map, associated with the
+// zero value of V. Keys already in the map keep their value.
+func (s *KeySet[K, V]) Add(values ...K) {
+	var zero V
+	for _, value := range values {
+		if !s.m.ContainsKey(value) {
+			s.m.Put(value, zero)
+		}
+	}
+}
+
+// Remove deletes the keys, and their values, from the map.
+func (s *KeySet[K, V]) Remove(values ...K) {
+	s.m.Delete(values...)
+}
+
+// Contains checks if all specified keys are in the map.
+func (s *KeySet[K, V]) Contains(values ...K) bool {
+	for _, value := range values {
+		if !s.m.ContainsKey(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Size returns the number of keys.
+func (s *KeySet[K, V]) Size() int {
+	return s.m.Size()
+}
+
+// IsEmpty checks if there are no keys.
+func (s *KeySet[K, V]) IsEmpty() bool {
+	return s.m.IsEmpty()
+}
+
+// Clear removes all keys, and their values, from the map.
+func (s *KeySet[K, V]) Clear() {
+	s.m.Clear()
+}
+
+// ToString returns a string representation of the key set.
+func (s *KeySet[K, V]) ToString() string {
+	var sb strings.Builder
+	sb.WriteString("[")
+	for i, key := range s.m.Keys() {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v", key))
+	}
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// ToSlice returns the keys in order.
+func (s *KeySet[K, V]) ToSlice() []K {
+	return s.m.Keys()
+}
+
+// First returns the lowest key.
+func (s *KeySet[K, V]) First() (K, bool) {
+	return s.m.FirstKey()
+}
+
+// Last returns the highest key.
+func (s *KeySet[K, V]) Last() (K, bool) {
+	return s.m.LastKey()
+}
+
+// Floor returns the greatest key less than or equal to value.
+func (s *KeySet[K, V]) Floor(value K) (K, bool) {
+	return s.m.FloorKey(value)
+}
+
+// Ceiling returns the least key greater than or equal to value.
+func (s *KeySet[K, V]) Ceiling(value K) (K, bool) {
+	return s.m.CeilingKey(value)
+}
+
+// Lower returns the greatest key strictly less than value.
+func (s *KeySet[K, V]) Lower(value K) (K, bool) {
+	return s.m.LowerKey(value)
+}
+
+// Higher returns the least key strictly greater than value.
+func (s *KeySet[K, V]) Higher(value K) (K, bool) {
+	return s.m.HigherKey(value)
+}
+
+// PollFirst removes and returns the lowest key.
+func (s *KeySet[K, V]) PollFirst() (K, bool) {
+	entry, ok := s.m.PollFirst()
+	return entry.Key, ok
+}
+
+// PollLast removes and returns the highest key.
+func (s *KeySet[K, V]) PollLast() (K, bool) {
+	entry, ok := s.m.PollLast()
+	return entry.Key, ok
+}
+
+// HeadSet returns a view of the keys that come before to.
+func (s *KeySet[K, V]) HeadSet(to K, inclusive bool) *KeySet[K, V] {
+	return s.m.HeadMap(to, inclusive).KeySet()
+}
+
+// TailSet returns a view of the keys that come after from.
+func (s *KeySet[K, V]) TailSet(from K, inclusive bool) *KeySet[K, V] {
+	return s.m.TailMap(from, inclusive).KeySet()
+}
+
+// SubSet returns a view of the keys between from and to.
+func (s *KeySet[K, V]) SubSet(from K, fromInclusive bool, to K, toInclusive bool) *KeySet[K, V] {
+	return s.m.SubMap(from, fromInclusive, to, toInclusive).KeySet()
+}
+
+// DescendingSet returns a view of the same keys in reverse order.
+func (s *KeySet[K, V]) DescendingSet() *KeySet[K, V] {
+	return s.m.DescendingMap().KeySet()
+}
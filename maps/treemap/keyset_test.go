@@ -0,0 +1,70 @@
+package treemap_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps/treemap"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func byID(a, b *mocks.MockSetable) int {
+	return strings.Compare(a.ID, b.ID)
+}
+
+func TestKeySet_SortedSet(t *testing.T) {
+	m := treemap.New[*mocks.MockSetable, int](byID)
+	itemA := mocks.NewMockSetable("a")
+	itemB := mocks.NewMockSetable("b")
+	itemC := mocks.NewMockSetable("c")
+
+	var keys set.SortedSet[*mocks.MockSetable] = m.KeySet()
+	keys.Add(itemC, itemA)
+	m.Put(itemB, 2)
+
+	assert.Equal(t, 3, keys.Size())
+	assert.True(t, keys.Contains(itemA, itemB, itemC))
+	assert.Equal(t, []*mocks.MockSetable{itemA, itemB, itemC}, keys.ToSlice())
+
+	first, _ := keys.First()
+	last, _ := keys.Last()
+	assert.Equal(t, itemA, first)
+	assert.Equal(t, itemC, last)
+
+	floor, _ := keys.Floor(mocks.NewMockSetable("bb"))
+	assert.Equal(t, itemB, floor)
+	ceiling, _ := keys.Ceiling(mocks.NewMockSetable("bb"))
+	assert.Equal(t, itemC, ceiling)
+	lower, _ := keys.Lower(itemB)
+	assert.Equal(t, itemA, lower)
+	higher, _ := keys.Higher(itemB)
+	assert.Equal(t, itemC, higher)
+
+	polled, _ := keys.PollFirst()
+	assert.Equal(t, itemA, polled)
+	polled, _ = keys.PollLast()
+	assert.Equal(t, itemC, polled)
+
+	keys.Remove(itemB)
+	assert.True(t, keys.IsEmpty())
+	assert.True(t, m.IsEmpty())
+}
+
+func TestKeySet_Views(t *testing.T) {
+	m := treemap.NewOrdered[int, bool]()
+	keys := m.KeySet()
+	keys.Add(1, 2, 3, 4, 5)
+
+	assert.Equal(t, []int{1, 2}, keys.HeadSet(3, false).ToSlice())
+	assert.Equal(t, []int{3, 4, 5}, keys.TailSet(3, true).ToSlice())
+	assert.Equal(t, []int{2, 3, 4}, keys.SubSet(2, true, 4, true).ToSlice())
+	assert.Equal(t, "[5, 4, 3, 2, 1]", keys.DescendingSet().ToString())
+
+	keys.SubSet(2, false, 5, false).Clear()
+	assert.Equal(t, []int{1, 2, 5}, keys.ToSlice())
+
+	keys.Clear()
+	assert.Equal(t, 0, m.Size())
+}
@@ -0,0 +1,445 @@
+package treemap
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/rbtree"
+)
+
+// bound is one end of the key range visible through a view.
+type bound[K any] struct {
+	key       K
+	inclusive bool
+	set       bool
+}
+
+// TreeMap is a sorted map backed by a red-black tree.
+// HeadMap, TailMap, SubMap and DescendingMap return live views that share
+// the same tree; a view only exposes the keys inside its range and
+// iterates them in its own direction.
+type TreeMap[K any, V any] struct {
+	tree       *rbtree.Tree[K, V]
+	lo, hi     bound[K]
+	descending bool
+}
+
+// New creates an empty TreeMap ordered by compare, which must return a
+// negative number, zero or a positive number when a is less than, equal
+// to or greater than b.
+func New[K any, V any](compare func(a, b K) int) *TreeMap[K, V] {
+	return &TreeMap[K, V]{tree: rbtree.New[K, V](compare)}
+}
+
+// NewOrdered creates an empty TreeMap using the natural order of K.
+func NewOrdered[K cmp.Ordered, V any]() *TreeMap[K, V] {
+	return New[K, V](cmp.Compare[K])
+}
+
+// Comparator returns the function that orders the keys of the map.
+func (m *TreeMap[K, V]) Comparator() func(a, b K) int {
+	return m.tree.Comparator()
+}
+
+// Put associates value with key.
+// It panics if key is outside the range of a view.
+func (m *TreeMap[K, V]) Put(key K, value V) {
+	if !m.inRange(key) {
+		panic("treemap: key out of range")
+	}
+	m.tree.Put(key, value)
+}
+
+// Get returns the value associated with key and whether it was found.
+func (m *TreeMap[K, V]) Get(key K) (V, bool) {
+	if n := m.find(key); n != nil {
+		return n.Value(), true
+	}
+	var zero V
+	return zero, false
+}
+
+// Delete removes one or more keys from the map.
+func (m *TreeMap[K, V]) Delete(keys ...K) {
+	for _, key := range keys {
+		if n := m.find(key); n != nil {
+			m.tree.Remove(n)
+		}
+	}
+}
+
+// ContainsKey checks if the key is present in the map.
+func (m *TreeMap[K, V]) ContainsKey(key K) bool {
+	return m.find(key) != nil
+}
+
+// Size returns the number of entries in the map. For a bounded view
+// this counts the entries in range.
+func (m *TreeMap[K, V]) Size() int {
+	if !m.lo.set && !m.hi.set {
+		return m.tree.Len()
+	}
+	size := 0
+	for n := m.first(); n != nil; n = m.next(n) {
+		size++
+	}
+	return size
+}
+
+// IsEmpty checks if the map has no entries.
+func (m *TreeMap[K, V]) IsEmpty() bool {
+	return m.first() == nil
+}
+
+// Clear removes all entries from the map, or from the range of a view.
+func (m *TreeMap[K, V]) Clear() {
+	if !m.lo.set && !m.hi.set {
+		m.tree.Clear()
+		return
+	}
+	m.Delete(m.Keys()...)
+}
+
+// Each calls fn for every entry in order until fn returns false.
+// fn must not modify the map.
+func (m *TreeMap[K, V]) Each(fn func(key K, value V) bool) {
+	for n := m.first(); n != nil; n = m.next(n) {
+		if !fn(n.Key(), n.Value()) {
+			return
+		}
+	}
+}
+
+// Keys returns the keys in order.
+func (m *TreeMap[K, V]) Keys() []K {
+	keys := make([]K, 0)
+	m.Each(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Values returns the values in key order.
+func (m *TreeMap[K, V]) Values() []V {
+	values := make([]V, 0)
+	m.Each(func(_ K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+// Entries returns the key-value pairs in key order.
+func (m *TreeMap[K, V]) Entries() []maps.Entry[K, V] {
+	entries := make([]maps.Entry[K, V], 0)
+	m.Each(func(key K, value V) bool {
+		entries = append(entries, maps.Entry[K, V]{Key: key, Value: value})
+		return true
+	})
+	return entries
+}
+
+// FirstKey returns the lowest key.
+func (m *TreeMap[K, V]) FirstKey() (K, bool) {
+	return keyOf(m.first())
+}
+
+// LastKey returns the highest key.
+func (m *TreeMap[K, V]) LastKey() (K, bool) {
+	return keyOf(m.last())
+}
+
+// FirstEntry returns the entry with the lowest key.
+func (m *TreeMap[K, V]) FirstEntry() (maps.Entry[K, V], bool) {
+	return entryOf(m.first())
+}
+
+// LastEntry returns the entry with the highest key.
+func (m *TreeMap[K, V]) LastEntry() (maps.Entry[K, V], bool) {
+	return entryOf(m.last())
+}
+
+// PollFirst removes and returns the entry with the lowest key.
+func (m *TreeMap[K, V]) PollFirst() (maps.Entry[K, V], bool) {
+	return m.poll(m.first())
+}
+
+// PollLast removes and returns the entry with the highest key.
+func (m *TreeMap[K, V]) PollLast() (maps.Entry[K, V], bool) {
+	return m.poll(m.last())
+}
+
+// FloorKey returns the greatest key less than or equal to key.
+func (m *TreeMap[K, V]) FloorKey(key K) (K, bool) {
+	if m.descending {
+		return keyOf(m.ascendingCeiling(key, true))
+	}
+	return keyOf(m.ascendingFloor(key, true))
+}
+
+// CeilingKey returns the least key greater than or equal to key.
+func (m *TreeMap[K, V]) CeilingKey(key K) (K, bool) {
+	if m.descending {
+		return keyOf(m.ascendingFloor(key, true))
+	}
+	return keyOf(m.ascendingCeiling(key, true))
+}
+
+// LowerKey returns the greatest key strictly less than key.
+func (m *TreeMap[K, V]) LowerKey(key K) (K, bool) {
+	if m.descending {
+		return keyOf(m.ascendingCeiling(key, false))
+	}
+	return keyOf(m.ascendingFloor(key, false))
+}
+
+// HigherKey returns the least key strictly greater than key.
+func (m *TreeMap[K, V]) HigherKey(key K) (K, bool) {
+	if m.descending {
+		return keyOf(m.ascendingFloor(key, false))
+	}
+	return keyOf(m.ascendingCeiling(key, false))
+}
+
+// HeadMap returns a view of the entries whose keys come before to,
+// including to itself when inclusive is set.
+func (m *TreeMap[K, V]) HeadMap(to K, inclusive bool) *TreeMap[K, V] {
+	view := *m
+	if m.descending {
+		view.lo = m.tighterLow(bound[K]{key: to, inclusive: inclusive, set: true})
+	} else {
+		view.hi = m.tighterHigh(bound[K]{key: to, inclusive: inclusive, set: true})
+	}
+	return &view
+}
+
+// TailMap returns a view of the entries whose keys come after from,
+// including from itself when inclusive is set.
+func (m *TreeMap[K, V]) TailMap(from K, inclusive bool) *TreeMap[K, V] {
+	view := *m
+	if m.descending {
+		view.hi = m.tighterHigh(bound[K]{key: from, inclusive: inclusive, set: true})
+	} else {
+		view.lo = m.tighterLow(bound[K]{key: from, inclusive: inclusive, set: true})
+	}
+	return &view
+}
+
+// SubMap returns a view of the entries whose keys lie between from and to.
+func (m *TreeMap[K, V]) SubMap(from K, fromInclusive bool, to K, toInclusive bool) *TreeMap[K, V] {
+	return m.TailMap(from, fromInclusive).HeadMap(to, toInclusive)
+}
+
+// DescendingMap returns a view of the same entries in reverse order.
+func (m *TreeMap[K, V]) DescendingMap() *TreeMap[K, V] {
+	view := *m
+	view.descending = !m.descending
+	return &view
+}
+
+// KeySet returns a live sorted set view of the keys in the map.
+func (m *TreeMap[K, V]) KeySet() *KeySet[K, V] {
+	return &KeySet[K, V]{m: m}
+}
+
+// ToString returns a string representation of the map.
+func (m *TreeMap[K, V]) ToString() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	first := true
+	m.Each(func(key K, value V) bool {
+		if !first {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v=%v", key, value))
+		first = false
+		return true
+	})
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// find returns the node for key if it is in range.
+func (m *TreeMap[K, V]) find(key K) *rbtree.Node[K, V] {
+	if !m.inRange(key) {
+		return nil
+	}
+	return m.tree.Find(key)
+}
+
+// poll removes n from the tree and returns its entry.
+func (m *TreeMap[K, V]) poll(n *rbtree.Node[K, V]) (maps.Entry[K, V], bool) {
+	entry, ok := entryOf(n)
+	if ok {
+		m.tree.Remove(n)
+	}
+	return entry, ok
+}
+
+// first returns the first node in view order.
+func (m *TreeMap[K, V]) first() *rbtree.Node[K, V] {
+	if m.descending {
+		return m.highest()
+	}
+	return m.lowest()
+}
+
+// last returns the last node in view order.
+func (m *TreeMap[K, V]) last() *rbtree.Node[K, V] {
+	if m.descending {
+		return m.lowest()
+	}
+	return m.highest()
+}
+
+// next returns the node after n in view order.
+func (m *TreeMap[K, V]) next(n *rbtree.Node[K, V]) *rbtree.Node[K, V] {
+	if m.descending {
+		n = n.Prev()
+		if n == nil || m.tooLow(n.Key()) {
+			return nil
+		}
+		return n
+	}
+	n = n.Next()
+	if n == nil || m.tooHigh(n.Key()) {
+		return nil
+	}
+	return n
+}
+
+// lowest returns the node with the lowest key in range.
+func (m *TreeMap[K, V]) lowest() *rbtree.Node[K, V] {
+	var n *rbtree.Node[K, V]
+	if m.lo.set {
+		n = m.ceiling(m.lo.key, m.lo.inclusive)
+	} else {
+		n = m.tree.First()
+	}
+	if n == nil || m.tooHigh(n.Key()) {
+		return nil
+	}
+	return n
+}
+
+// highest returns the node with the highest key in range.
+func (m *TreeMap[K, V]) highest() *rbtree.Node[K, V] {
+	var n *rbtree.Node[K, V]
+	if m.hi.set {
+		n = m.floor(m.hi.key, m.hi.inclusive)
+	} else {
+		n = m.tree.Last()
+	}
+	if n == nil || m.tooLow(n.Key()) {
+		return nil
+	}
+	return n
+}
+
+// ascendingFloor returns the greatest in-range node below key.
+func (m *TreeMap[K, V]) ascendingFloor(key K, inclusive bool) *rbtree.Node[K, V] {
+	if m.tooHigh(key) {
+		return m.highest()
+	}
+	n := m.floor(key, inclusive)
+	if n == nil || m.tooLow(n.Key()) {
+		return nil
+	}
+	return n
+}
+
+// ascendingCeiling returns the least in-range node above key.
+func (m *TreeMap[K, V]) ascendingCeiling(key K, inclusive bool) *rbtree.Node[K, V] {
+	if m.tooLow(key) {
+		return m.lowest()
+	}
+	n := m.ceiling(key, inclusive)
+	if n == nil || m.tooHigh(n.Key()) {
+		return nil
+	}
+	return n
+}
+
+// floor returns the greatest node below key, or at key when inclusive is set.
+func (m *TreeMap[K, V]) floor(key K, inclusive bool) *rbtree.Node[K, V] {
+	if inclusive {
+		return m.tree.Floor(key)
+	}
+	return m.tree.Lower(key)
+}
+
+// ceiling returns the least node above key, or at key when inclusive is set.
+func (m *TreeMap[K, V]) ceiling(key K, inclusive bool) *rbtree.Node[K, V] {
+	if inclusive {
+		return m.tree.Ceiling(key)
+	}
+	return m.tree.Higher(key)
+}
+
+func (m *TreeMap[K, V]) compare(a, b K) int {
+	return m.tree.Comparator()(a, b)
+}
+
+func (m *TreeMap[K, V]) inRange(key K) bool {
+	return !m.tooLow(key) && !m.tooHigh(key)
+}
+
+func (m *TreeMap[K, V]) tooLow(key K) bool {
+	if !m.lo.set {
+		return false
+	}
+	c := m.compare(key, m.lo.key)
+	return c < 0 || (c == 0 && !m.lo.inclusive)
+}
+
+func (m *TreeMap[K, V]) tooHigh(key K) bool {
+	if !m.hi.set {
+		return false
+	}
+	c := m.compare(key, m.hi.key)
+	return c > 0 || (c == 0 && !m.hi.inclusive)
+}
+
+// tighterLow returns whichever of the current and the given lower bound
+// admits fewer keys.
+func (m *TreeMap[K, V]) tighterLow(b bound[K]) bound[K] {
+	if !m.lo.set {
+		return b
+	}
+	c := m.compare(b.key, m.lo.key)
+	if c > 0 || (c == 0 && !b.inclusive) {
+		return b
+	}
+	return m.lo
+}
+
+// tighterHigh returns whichever of the current and the given upper bound
+// admits fewer keys.
+func (m *TreeMap[K, V]) tighterHigh(b bound[K]) bound[K] {
+	if !m.hi.set {
+		return b
+	}
+	c := m.compare(b.key, m.hi.key)
+	if c < 0 || (c == 0 && !b.inclusive) {
+		return b
+	}
+	return m.hi
+}
+
+func keyOf[K any, V any](n *rbtree.Node[K, V]) (K, bool) {
+	if n == nil {
+		var zero K
+		return zero, false
+	}
+	return n.Key(), true
+}
+
+func entryOf[K any, V any](n *rbtree.Node[K, V]) (maps.Entry[K, V], bool) {
+	if n == nil {
+		return maps.Entry[K, V]{}, false
+	}
+	return maps.Entry[K, V]{Key: n.Key(), Value: n.Value()}, true
+}
@@ -0,0 +1,177 @@
+package treemap_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps/treemap"
+	"github.com/stretchr/testify/assert"
+)
+
+func newMap(keys ...int) *treemap.TreeMap[int, string] {
+	m := treemap.NewOrdered[int, string]()
+	for _, key := range keys {
+		m.Put(key, string(rune('a'+key)))
+	}
+	return m
+}
+
+func TestTreeMap_BasicOperations(t *testing.T) {
+	var m maps.Map[int, string] = newMap(5, 1, 3)
+
+	assert.Equal(t, 3, m.Size())
+	assert.Equal(t, []int{1, 3, 5}, m.Keys())
+	assert.Equal(t, []string{"b", "d", "f"}, m.Values())
+	assert.Equal(t, "{1=b, 3=d, 5=f}", m.ToString())
+
+	m.Put(3, "x")
+	value, ok := m.Get(3)
+	assert.True(t, ok)
+	assert.Equal(t, "x", value)
+
+	m.Delete(1, 7)
+	assert.False(t, m.ContainsKey(1))
+	assert.Equal(t, []maps.Entry[int, string]{{Key: 3, Value: "x"}, {Key: 5, Value: "f"}}, m.Entries())
+
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+}
+
+func TestTreeMap_Navigation(t *testing.T) {
+	m := newMap(10, 20, 30, 40)
+
+	floor, ok := m.FloorKey(25)
+	assert.True(t, ok)
+	assert.Equal(t, 20, floor)
+	floor, _ = m.FloorKey(20)
+	assert.Equal(t, 20, floor)
+	_, ok = m.FloorKey(5)
+	assert.False(t, ok)
+
+	ceiling, _ := m.CeilingKey(25)
+	assert.Equal(t, 30, ceiling)
+	_, ok = m.CeilingKey(45)
+	assert.False(t, ok)
+
+	lower, _ := m.LowerKey(20)
+	assert.Equal(t, 10, lower)
+	higher, _ := m.HigherKey(20)
+	assert.Equal(t, 30, higher)
+
+	firstKey, _ := m.FirstKey()
+	lastKey, _ := m.LastKey()
+	assert.Equal(t, 10, firstKey)
+	assert.Equal(t, 40, lastKey)
+}
+
+func TestTreeMap_Poll(t *testing.T) {
+	m := newMap(2, 1, 3)
+
+	first, ok := m.PollFirst()
+	assert.True(t, ok)
+	assert.Equal(t, 1, first.Key)
+	last, _ := m.PollLast()
+	assert.Equal(t, 3, last.Key)
+	assert.Equal(t, []int{2}, m.Keys())
+
+	m.PollFirst()
+	_, ok = m.PollLast()
+	assert.False(t, ok)
+}
+
+func TestTreeMap_Views(t *testing.T) {
+	m := newMap(10, 20, 30, 40, 50)
+
+	assert.Equal(t, []int{10, 20}, m.HeadMap(30, false).Keys())
+	assert.Equal(t, []int{10, 20, 30}, m.HeadMap(30, true).Keys())
+	assert.Equal(t, []int{40, 50}, m.TailMap(30, false).Keys())
+	assert.Equal(t, []int{20, 30, 40}, m.SubMap(15, true, 40, true).Keys())
+
+	// Views are live in both directions
+	sub := m.SubMap(20, true, 40, false)
+	m.Put(25, "z")
+	assert.Equal(t, []int{20, 25, 30}, sub.Keys())
+	assert.Equal(t, 3, sub.Size())
+	sub.Delete(20)
+	assert.False(t, m.ContainsKey(20))
+	sub.Clear()
+	assert.Equal(t, []int{10, 40, 50}, m.Keys())
+
+	// Nested views never widen the range
+	assert.Equal(t, []int{40}, m.HeadMap(45, false).TailMap(30, true).Keys())
+	assert.Empty(t, m.HeadMap(20, false).TailMap(30, true).Keys())
+
+	floor, ok := m.TailMap(40, true).FloorKey(35)
+	assert.False(t, ok)
+	floor, ok = m.HeadMap(40, false).FloorKey(100)
+	assert.True(t, ok)
+	assert.Equal(t, 10, floor)
+
+	assert.Panics(t, func() { m.HeadMap(20, false).Put(30, "out") })
+}
+
+func TestTreeMap_DescendingMap(t *testing.T) {
+	m := newMap(10, 20, 30, 40)
+	desc := m.DescendingMap()
+
+	assert.Equal(t, []int{40, 30, 20, 10}, desc.Keys())
+	assert.Equal(t, []int{40, 30}, desc.HeadMap(20, false).Keys())
+	assert.Equal(t, []int{20, 10}, desc.TailMap(20, true).Keys())
+	assert.Equal(t, []int{30, 20}, desc.SubMap(30, true, 10, false).Keys())
+
+	floor, _ := desc.FloorKey(25)
+	assert.Equal(t, 30, floor)
+	ceiling, _ := desc.CeilingKey(25)
+	assert.Equal(t, 20, ceiling)
+	first, _ := desc.FirstKey()
+	assert.Equal(t, 40, first)
+
+	polled, _ := desc.PollFirst()
+	assert.Equal(t, 40, polled.Key)
+	assert.Equal(t, []int{10, 20, 30}, desc.DescendingMap().Keys())
+}
+
+func TestTreeMap_Comparator(t *testing.T) {
+	byLength := func(a, b string) int { return len(a) - len(b) }
+	m := treemap.New[string, int](byLength)
+	m.Put("ccc", 3)
+	m.Put("a", 1)
+	m.Put("bb", 2)
+	m.Put("dd", 4)
+
+	assert.Equal(t, []string{"a", "bb", "ccc"}, m.Keys())
+	assert.Equal(t, []int{1, 4, 3}, m.Values())
+	assert.Equal(t, 0, m.Comparator()("xx", "yy"))
+}
+
+func TestTreeMap_RandomOperations(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	m := treemap.NewOrdered[int, int]()
+	reference := make(map[int]int)
+
+	for i := 0; i < 5000; i++ {
+		key := rng.Intn(500)
+		if rng.Intn(3) == 0 {
+			m.Delete(key)
+			delete(reference, key)
+		} else {
+			m.Put(key, i)
+			reference[key] = i
+		}
+	}
+
+	keys := make([]int, 0, len(reference))
+	for key := range reference {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+	assert.Equal(t, keys, m.Keys())
+	assert.Equal(t, len(reference), m.Size())
+	for _, key := range keys {
+		value, ok := m.Get(key)
+		assert.True(t, ok)
+		assert.Equal(t, reference[key], value)
+	}
+}
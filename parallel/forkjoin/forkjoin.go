@@ -0,0 +1,272 @@
+package forkjoin
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/queue/deque"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/queue/workstealing"
+)
+
+// ErrClosed is returned when work is submitted to a closed Pool.
+var ErrClosed = errors.New("forkjoin: pool is closed")
+
+// Task is a unit of work on a Pool. Workers wait for a task with Join;
+// goroutines outside the pool wait with Wait.
+type Task struct {
+	fn       func(w *Worker)
+	done     atomic.Bool
+	panics   any
+	finished chan struct{}
+}
+
+// run executes the task, recording a panic for Join and Wait to rethrow.
+func (t *Task) run(w *Worker) {
+	defer func() {
+		t.panics = recover()
+		t.done.Store(true)
+		if t.finished != nil {
+			close(t.finished)
+		}
+	}()
+	t.fn(w)
+}
+
+// Wait blocks until the task has run, for callers outside the pool; a
+// worker must use Join instead so that it keeps running tasks. If the
+// task panicked, Wait panics with the same value.
+func (t *Task) Wait() {
+	if t.finished != nil {
+		<-t.finished
+	} else {
+		for !t.done.Load() {
+			runtime.Gosched()
+		}
+	}
+	if t.panics != nil {
+		panic(t.panics)
+	}
+}
+
+// Join waits until the task has run. While waiting, w runs other tasks,
+// its own first and then stolen ones, so that joining never idles a
+// worker. If the task panicked, Join panics with the same value.
+func (t *Task) Join(w *Worker) {
+	for !t.done.Load() {
+		if !w.runOne() {
+			runtime.Gosched()
+		}
+	}
+	if t.panics != nil {
+		panic(t.panics)
+	}
+}
+
+// Worker is a goroutine of a Pool with its own work-stealing deque.
+// Tasks receive the worker running them so they can fork subtasks.
+type Worker struct {
+	pool  *Pool
+	tasks *workstealing.Deque[Task]
+	seed  uint32
+}
+
+// Fork schedules fn as a subtask on w's deque and returns it. Idle workers
+// may steal it; otherwise w runs it when it joins.
+func (w *Worker) Fork(fn func(w *Worker)) *Task {
+	t := &Task{fn: fn}
+	w.tasks.Push(t)
+	w.pool.wake()
+	return t
+}
+
+// Invoke runs every fn in parallel and waits for all of them, running
+// the first on w itself.
+func (w *Worker) Invoke(fns ...func(w *Worker)) {
+	if len(fns) == 0 {
+		return
+	}
+	tasks := make([]*Task, len(fns)-1)
+	for i, fn := range fns[1:] {
+		tasks[i] = w.Fork(fn)
+	}
+	fns[0](w)
+	for i := len(tasks) - 1; i >= 0; i-- {
+		tasks[i].Join(w)
+	}
+}
+
+// runOne runs one task from w's deque, another worker's deque or the
+// submission queue, and reports whether it found one.
+func (w *Worker) runOne() bool {
+	t := w.tasks.Pop()
+	if t == nil {
+		t = w.steal()
+	}
+	if t == nil {
+		t = w.pool.poll()
+	}
+	if t == nil {
+		return false
+	}
+	t.run(w)
+	return true
+}
+
+// steal tries every other worker once, starting at a random victim.
+func (w *Worker) steal() *Task {
+	workers := w.pool.workers
+	w.seed ^= w.seed << 13
+	w.seed ^= w.seed >> 17
+	w.seed ^= w.seed << 5
+	start := int(w.seed % uint32(len(workers)))
+	for i := range workers {
+		victim := workers[(start+i)%len(workers)]
+		if victim == w {
+			continue
+		}
+		if t := victim.tasks.Steal(); t != nil {
+			return t
+		}
+	}
+	return nil
+}
+
+// loop runs tasks until the pool is closed, parking when there are none.
+func (w *Worker) loop() {
+	defer w.pool.wg.Done()
+	for {
+		if w.runOne() {
+			continue
+		}
+		p := w.pool
+		p.idle.Add(1)
+		// Look again after announcing that we are idle, so that work
+		// pushed before the announcement is not missed
+		if w.runOne() {
+			p.idle.Add(-1)
+			continue
+		}
+		select {
+		case <-p.signal:
+			p.idle.Add(-1)
+		case <-p.quit:
+			p.idle.Add(-1)
+			if !w.runOne() {
+				return
+			}
+		}
+	}
+}
+
+// Pool is a fork-join pool: a fixed set of workers that each keep their
+// own deque of tasks and steal from each other when they run out. It suits
+// recursive divide-and-conquer work such as parallel sorts and graph
+// traversals, which fork many small tasks without a goroutine each.
+type Pool struct {
+	workers  []*Worker
+	mu       sync.Mutex
+	injected *deque.Deque[*Task]
+	pending  atomic.Int64
+	idle     atomic.Int32
+	signal   chan struct{}
+	quit     chan struct{}
+	closed   bool
+	wg       sync.WaitGroup
+}
+
+// New starts a Pool with the given number of workers, or GOMAXPROCS
+// workers if parallelism is not positive.
+func New(parallelism int) *Pool {
+	if parallelism <= 0 {
+		parallelism = runtime.GOMAXPROCS(0)
+	}
+	p := &Pool{
+		injected: deque.New[*Task](),
+		signal:   make(chan struct{}, parallelism),
+		quit:     make(chan struct{}),
+	}
+	p.workers = make([]*Worker, parallelism)
+	for i := range p.workers {
+		p.workers[i] = &Worker{pool: p, tasks: workstealing.New[Task](), seed: uint32(i)*2654435761 + 1}
+	}
+	p.wg.Add(parallelism)
+	for _, w := range p.workers {
+		go w.loop()
+	}
+	return p
+}
+
+// Parallelism returns the number of workers.
+func (p *Pool) Parallelism() int {
+	return len(p.workers)
+}
+
+// Submit schedules fn on the pool from outside it and returns its task,
+// to be waited for with Task.Wait. Tasks running on the pool should fork
+// with Worker.Fork instead.
+func (p *Pool) Submit(fn func(w *Worker)) (*Task, error) {
+	t := &Task{fn: fn, finished: make(chan struct{})}
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil, ErrClosed
+	}
+	p.injected.PushBack(t)
+	p.pending.Add(1)
+	p.mu.Unlock()
+	p.wake()
+	return t, nil
+}
+
+// Invoke runs fn on the pool and waits for it. If fn panics, Invoke
+// panics with the same value in the caller's goroutine.
+func (p *Pool) Invoke(fn func(w *Worker)) error {
+	t, err := p.Submit(fn)
+	if err != nil {
+		return err
+	}
+	t.Wait()
+	return nil
+}
+
+// Close stops the workers once the submitted tasks have run and waits for
+// them to exit. Submitting afterwards returns ErrClosed.
+func (p *Pool) Close() {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return
+	}
+	p.closed = true
+	p.mu.Unlock()
+	close(p.quit)
+	p.wg.Wait()
+}
+
+// poll takes a task from the submission queue.
+func (p *Pool) poll() *Task {
+	if p.pending.Load() == 0 {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	t, ok := p.injected.PopFront()
+	if !ok {
+		return nil
+	}
+	p.pending.Add(-1)
+	return t
+}
+
+// wake unparks one idle worker, if any.
+func (p *Pool) wake() {
+	if p.idle.Load() == 0 {
+		return
+	}
+	select {
+	case p.signal <- struct{}{}:
+	default:
+	}
+}
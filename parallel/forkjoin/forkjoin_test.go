@@ -0,0 +1,125 @@
+package forkjoin_test
+
+import (
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/parallel/forkjoin"
+	"github.com/stretchr/testify/assert"
+)
+
+// fib computes Fibonacci numbers by forking both recursive calls.
+func fib(w *forkjoin.Worker, n int) int {
+	if n < 2 {
+		return n
+	}
+	var a, b int
+	w.Invoke(
+		func(w *forkjoin.Worker) { a = fib(w, n-1) },
+		func(w *forkjoin.Worker) { b = fib(w, n-2) },
+	)
+	return a + b
+}
+
+// mergeSort sorts values in parallel, falling back to a sequential sort
+// for small ranges.
+func mergeSort(w *forkjoin.Worker, values, scratch []int) {
+	if len(values) <= 64 {
+		slices.Sort(values)
+		return
+	}
+	mid := len(values) / 2
+	right := w.Fork(func(w *forkjoin.Worker) { mergeSort(w, values[mid:], scratch[mid:]) })
+	mergeSort(w, values[:mid], scratch[:mid])
+	right.Join(w)
+
+	copy(scratch, values)
+	i, j, k := 0, mid, 0
+	for i < mid && j < len(values) {
+		if scratch[j] < scratch[i] {
+			values[k], j = scratch[j], j+1
+		} else {
+			values[k], i = scratch[i], i+1
+		}
+		k++
+	}
+	copy(values[k:], scratch[i:mid])
+	copy(values[k+mid-i:], scratch[j:])
+}
+
+func TestPool_RecursiveTasks(t *testing.T) {
+	pool := forkjoin.New(4)
+	defer pool.Close()
+	assert.Equal(t, 4, pool.Parallelism())
+
+	var result int
+	assert.NoError(t, pool.Invoke(func(w *forkjoin.Worker) { result = fib(w, 20) }))
+	assert.Equal(t, 6765, result)
+}
+
+func TestPool_ParallelMergeSort(t *testing.T) {
+	pool := forkjoin.New(0)
+	defer pool.Close()
+
+	values := rand.New(rand.NewSource(1)).Perm(50000)
+	scratch := make([]int, len(values))
+	assert.NoError(t, pool.Invoke(func(w *forkjoin.Worker) { mergeSort(w, values, scratch) }))
+	assert.True(t, slices.IsSorted(values))
+	assert.Equal(t, 0, values[0])
+	assert.Equal(t, 49999, values[len(values)-1])
+}
+
+func TestPool_ConcurrentSubmitters(t *testing.T) {
+	pool := forkjoin.New(3)
+	defer pool.Close()
+
+	var wg sync.WaitGroup
+	results := make([]int, 8)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			task, err := pool.Submit(func(w *forkjoin.Worker) { results[i] = fib(w, 15) })
+			assert.NoError(t, err)
+			task.Wait()
+		}(i)
+	}
+	wg.Wait()
+	for _, result := range results {
+		assert.Equal(t, 610, result)
+	}
+}
+
+func TestPool_PanicsPropagate(t *testing.T) {
+	pool := forkjoin.New(2)
+	defer pool.Close()
+
+	assert.PanicsWithValue(t, "boom", func() {
+		_ = pool.Invoke(func(w *forkjoin.Worker) {
+			task := w.Fork(func(*forkjoin.Worker) { panic("boom") })
+			task.Join(w)
+		})
+	})
+
+	// The pool keeps working after a task panicked
+	var result int
+	assert.NoError(t, pool.Invoke(func(w *forkjoin.Worker) { result = fib(w, 10) }))
+	assert.Equal(t, 55, result)
+}
+
+func TestPool_Close(t *testing.T) {
+	pool := forkjoin.New(2)
+	ran := false
+	task, err := pool.Submit(func(*forkjoin.Worker) { ran = true })
+	assert.NoError(t, err)
+	pool.Close()
+	task.Wait()
+	assert.True(t, ran)
+
+	pool.Close()
+	_, err = pool.Submit(func(*forkjoin.Worker) {})
+	assert.ErrorIs(t, err, forkjoin.ErrClosed)
+	assert.ErrorIs(t, pool.Invoke(func(*forkjoin.Worker) {}), forkjoin.ErrClosed)
+}
@@ -0,0 +1,92 @@
+package blocking
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/queue/deque"
+)
+
+// fifo stores elements in arrival order.
+type fifo[T any] struct {
+	items *deque.Deque[T]
+}
+
+func (s fifo[T]) push(value T) {
+	s.items.PushBack(value)
+}
+
+func (s fifo[T]) pop() T {
+	value, _ := s.items.PopFront()
+	return value
+}
+
+func (s fifo[T]) len() int {
+	return s.items.Len()
+}
+
+func (s fifo[T]) delay() time.Duration {
+	return 0
+}
+
+// BlockingQueue is a thread-safe FIFO queue. Take waits for an element and,
+// when the queue is bounded, Put waits for room. Both give up when their
+// context is done.
+type BlockingQueue[T any] struct {
+	core *core[T]
+}
+
+// New creates a BlockingQueue holding at most capacity elements.
+// It panics if capacity is not positive.
+func New[T any](capacity int) *BlockingQueue[T] {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("blocking: invalid capacity %d", capacity))
+	}
+	return &BlockingQueue[T]{core: newCore[T](fifo[T]{deque.NewWithCapacity[T](capacity)}, capacity)}
+}
+
+// NewUnbounded creates a BlockingQueue with no size limit, so Put never waits.
+func NewUnbounded[T any]() *BlockingQueue[T] {
+	return &BlockingQueue[T]{core: newCore[T](fifo[T]{deque.New[T]()}, 0)}
+}
+
+// Put adds value at the tail, waiting for room if the queue is full.
+// It returns ctx.Err() if ctx is done first.
+func (q *BlockingQueue[T]) Put(ctx context.Context, value T) error {
+	return q.core.put(ctx, value)
+}
+
+// Take removes and returns the head, waiting for an element if the queue
+// is empty. It returns ctx.Err() if ctx is done first.
+func (q *BlockingQueue[T]) Take(ctx context.Context) (T, error) {
+	return q.core.take(ctx)
+}
+
+// Offer adds value, waiting up to timeout for room. It reports whether
+// value was added; a timeout of zero or less does not wait.
+func (q *BlockingQueue[T]) Offer(value T, timeout time.Duration) bool {
+	return q.core.offer(value, timeout)
+}
+
+// Poll removes and returns the head, waiting up to timeout for one.
+// A timeout of zero or less does not wait.
+func (q *BlockingQueue[T]) Poll(timeout time.Duration) (T, bool) {
+	return q.core.poll(timeout)
+}
+
+// Drain removes and returns up to limit elements without waiting.
+// A limit of zero or less drains the whole queue.
+func (q *BlockingQueue[T]) Drain(limit int) []T {
+	return q.core.drain(limit)
+}
+
+// Len returns the number of elements in the queue.
+func (q *BlockingQueue[T]) Len() int {
+	return q.core.len()
+}
+
+// Capacity returns the size limit of the queue, or 0 if it is unbounded.
+func (q *BlockingQueue[T]) Capacity() int {
+	return q.core.capacity
+}
@@ -0,0 +1,130 @@
+package blocking_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/queue/blocking"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockingQueue_BasicOperations(t *testing.T) {
+	q := blocking.New[int](2)
+	ctx := context.Background()
+	assert.Equal(t, 2, q.Capacity())
+
+	assert.NoError(t, q.Put(ctx, 1))
+	assert.True(t, q.Offer(2, 0))
+	assert.False(t, q.Offer(3, 0))
+	assert.False(t, q.Offer(3, 10*time.Millisecond))
+	assert.Equal(t, 2, q.Len())
+
+	value, err := q.Take(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, value)
+	value, ok := q.Poll(0)
+	assert.True(t, ok)
+	assert.Equal(t, 2, value)
+	_, ok = q.Poll(10 * time.Millisecond)
+	assert.False(t, ok)
+}
+
+func TestBlockingQueue_PutWaitsForRoom(t *testing.T) {
+	q := blocking.New[int](1)
+	ctx := context.Background()
+	_ = q.Put(ctx, 1)
+
+	done := make(chan error)
+	go func() { done <- q.Put(ctx, 2) }()
+	select {
+	case <-done:
+		t.Fatal("Put should wait while the queue is full")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	value, _ := q.Take(ctx)
+	assert.Equal(t, 1, value)
+	assert.NoError(t, <-done)
+	value, _ = q.Take(ctx)
+	assert.Equal(t, 2, value)
+}
+
+func TestBlockingQueue_ContextCancellation(t *testing.T) {
+	q := blocking.New[int](1)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := q.Take(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_ = q.Put(context.Background(), 1)
+	cancelled, cancelNow := context.WithCancel(context.Background())
+	cancelNow()
+	assert.ErrorIs(t, q.Put(cancelled, 2), context.Canceled)
+	assert.Equal(t, 1, q.Len())
+}
+
+func TestBlockingQueue_Drain(t *testing.T) {
+	q := blocking.NewUnbounded[int]()
+	for i := 0; i < 5; i++ {
+		assert.True(t, q.Offer(i, 0))
+	}
+	assert.Equal(t, 0, q.Capacity())
+	assert.Equal(t, []int{0, 1}, q.Drain(2))
+	assert.Equal(t, []int{2, 3, 4}, q.Drain(0))
+	assert.Empty(t, q.Drain(0))
+}
+
+func TestBlockingQueue_DrainWakesPutters(t *testing.T) {
+	q := blocking.New[int](1)
+	_ = q.Put(context.Background(), 1)
+	done := make(chan error)
+	go func() { done <- q.Put(context.Background(), 2) }()
+	time.Sleep(10 * time.Millisecond)
+
+	assert.Equal(t, []int{1}, q.Drain(0))
+	assert.NoError(t, <-done)
+}
+
+func TestNew_InvalidCapacity(t *testing.T) {
+	assert.PanicsWithValue(t, "blocking: invalid capacity 0", func() { blocking.New[int](0) })
+}
+
+func TestBlockingQueue_ConcurrentProducersAndConsumers(t *testing.T) {
+	const producers, consumers, perProducer = 4, 4, 500
+	q := blocking.New[int](8)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				assert.NoError(t, q.Put(ctx, p*perProducer+i))
+			}
+		}(p)
+	}
+
+	var mu sync.Mutex
+	seen := make(map[int]bool)
+	for c := 0; c < consumers; c++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < producers*perProducer/consumers; i++ {
+				value, err := q.Take(ctx)
+				assert.NoError(t, err)
+				mu.Lock()
+				seen[value] = true
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+	assert.Len(t, seen, producers*perProducer)
+	assert.Equal(t, 0, q.Len())
+}
@@ -0,0 +1,187 @@
+package blocking
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// store holds the elements of a blocking queue. It is only used with the
+// queue's lock held.
+type store[T any] interface {
+	push(value T)
+	pop() T
+	len() int
+	// delay returns how long until the head may be taken; zero or less
+	// means now. It is only called on a non-empty store.
+	delay() time.Duration
+}
+
+// core implements the waiting shared by the blocking queues. Waiters block
+// on a channel that is closed, and replaced, when the state they wait for
+// may have changed, which lets them also select on a context.
+type core[T any] struct {
+	mu       sync.Mutex
+	store    store[T]
+	capacity int
+	notEmpty chan struct{}
+	notFull  chan struct{}
+	takers   int
+	putters  int
+}
+
+func newCore[T any](s store[T], capacity int) *core[T] {
+	return &core[T]{
+		store:    s,
+		capacity: capacity,
+		notEmpty: make(chan struct{}),
+		notFull:  make(chan struct{}),
+	}
+}
+
+// put adds value, waiting for room until ctx is done.
+func (q *core[T]) put(ctx context.Context, value T) error {
+	for {
+		q.mu.Lock()
+		if q.tryPutLocked(value) {
+			q.mu.Unlock()
+			return nil
+		}
+		wait := q.notFull
+		q.putters++
+		q.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+		}
+
+		q.mu.Lock()
+		q.putters--
+		q.mu.Unlock()
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// take removes the head, waiting until one is available and ready or
+// until ctx is done.
+func (q *core[T]) take(ctx context.Context) (T, error) {
+	for {
+		q.mu.Lock()
+		value, delay, ok := q.tryTakeLocked()
+		if ok {
+			q.mu.Unlock()
+			return value, nil
+		}
+		wait := q.notEmpty
+		q.takers++
+		q.mu.Unlock()
+
+		var timer *time.Timer
+		var ready <-chan time.Time
+		if delay > 0 {
+			timer = time.NewTimer(delay)
+			ready = timer.C
+		}
+		select {
+		case <-wait:
+		case <-ready:
+		case <-ctx.Done():
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+
+		q.mu.Lock()
+		q.takers--
+		q.mu.Unlock()
+		if err := ctx.Err(); err != nil {
+			var zero T
+			return zero, err
+		}
+	}
+}
+
+// offer is put with a timeout; a timeout of zero or less does not wait.
+func (q *core[T]) offer(value T, timeout time.Duration) bool {
+	if timeout <= 0 {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		return q.tryPutLocked(value)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return q.put(ctx, value) == nil
+}
+
+// poll is take with a timeout; a timeout of zero or less does not wait.
+func (q *core[T]) poll(timeout time.Duration) (T, bool) {
+	if timeout <= 0 {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		value, _, ok := q.tryTakeLocked()
+		return value, ok
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	value, err := q.take(ctx)
+	return value, err == nil
+}
+
+// drain removes up to limit ready elements without waiting.
+// A limit of zero or less removes all of them.
+func (q *core[T]) drain(limit int) []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var values []T
+	for q.store.len() > 0 && (limit <= 0 || len(values) < limit) && q.store.delay() <= 0 {
+		values = append(values, q.store.pop())
+	}
+	if len(values) > 0 && q.putters > 0 {
+		broadcast(&q.notFull)
+	}
+	return values
+}
+
+func (q *core[T]) len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.store.len()
+}
+
+// tryPutLocked adds value if there is room and wakes the takers.
+func (q *core[T]) tryPutLocked(value T) bool {
+	if q.capacity > 0 && q.store.len() >= q.capacity {
+		return false
+	}
+	q.store.push(value)
+	if q.takers > 0 {
+		broadcast(&q.notEmpty)
+	}
+	return true
+}
+
+// tryTakeLocked removes the head if it is ready. Otherwise it returns how
+// long until the head becomes ready, or zero if the queue is empty.
+func (q *core[T]) tryTakeLocked() (T, time.Duration, bool) {
+	var zero T
+	if q.store.len() == 0 {
+		return zero, 0, false
+	}
+	if delay := q.store.delay(); delay > 0 {
+		return zero, delay, false
+	}
+	value := q.store.pop()
+	if q.putters > 0 {
+		broadcast(&q.notFull)
+	}
+	return value, 0, true
+}
+
+// broadcast wakes every goroutine waiting on *ch.
+func broadcast(ch *chan struct{}) {
+	close(*ch)
+	*ch = make(chan struct{})
+}
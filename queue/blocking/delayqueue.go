@@ -0,0 +1,90 @@
+package blocking
+
+import (
+	"context"
+	"time"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/heap/binaryheap"
+)
+
+// delayed is an element with the time it becomes available.
+type delayed[T any] struct {
+	value T
+	at    time.Time
+}
+
+// schedule stores delayed elements ordered by their time.
+type schedule[T any] struct {
+	heap *binaryheap.Heap[delayed[T]]
+}
+
+func (s schedule[T]) push(value delayed[T]) {
+	s.heap.Push(value)
+}
+
+func (s schedule[T]) pop() delayed[T] {
+	value, _ := s.heap.Pop()
+	return value
+}
+
+func (s schedule[T]) len() int {
+	return s.heap.Len()
+}
+
+func (s schedule[T]) delay() time.Duration {
+	head, _ := s.heap.Peek()
+	return time.Until(head.at)
+}
+
+// DelayQueue is an unbounded thread-safe queue whose elements can only be
+// taken once their delay has expired. Elements come out in the order they
+// become available.
+type DelayQueue[T any] struct {
+	core *core[delayed[T]]
+}
+
+// NewDelay creates an empty DelayQueue.
+func NewDelay[T any]() *DelayQueue[T] {
+	byTime := func(a, b delayed[T]) int { return a.at.Compare(b.at) }
+	return &DelayQueue[T]{core: newCore[delayed[T]](schedule[T]{binaryheap.New(byTime)}, 0)}
+}
+
+// Put adds value to become available after delay.
+func (q *DelayQueue[T]) Put(value T, delay time.Duration) {
+	q.PutAt(value, time.Now().Add(delay))
+}
+
+// PutAt adds value to become available at the given time.
+func (q *DelayQueue[T]) PutAt(value T, at time.Time) {
+	q.core.offer(delayed[T]{value: value, at: at}, 0)
+}
+
+// Take removes and returns the first element whose delay has expired,
+// waiting until there is one. It returns ctx.Err() if ctx is done first.
+func (q *DelayQueue[T]) Take(ctx context.Context) (T, error) {
+	d, err := q.core.take(ctx)
+	return d.value, err
+}
+
+// Poll removes and returns the first expired element, waiting up to
+// timeout for one. A timeout of zero or less does not wait.
+func (q *DelayQueue[T]) Poll(timeout time.Duration) (T, bool) {
+	d, ok := q.core.poll(timeout)
+	return d.value, ok
+}
+
+// Drain removes and returns up to limit expired elements without waiting.
+// A limit of zero or less drains every expired element.
+func (q *DelayQueue[T]) Drain(limit int) []T {
+	drained := q.core.drain(limit)
+	values := make([]T, len(drained))
+	for i, d := range drained {
+		values[i] = d.value
+	}
+	return values
+}
+
+// Len returns the number of elements in the queue, expired or not.
+func (q *DelayQueue[T]) Len() int {
+	return q.core.len()
+}
@@ -0,0 +1,100 @@
+package blocking_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/queue/blocking"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelayQueue_ReleasesInDeadlineOrder(t *testing.T) {
+	q := blocking.NewDelay[string]()
+	start := time.Now()
+	q.Put("late", 60*time.Millisecond)
+	q.Put("early", 20*time.Millisecond)
+	q.Put("now", 0)
+	assert.Equal(t, 3, q.Len())
+
+	ctx := context.Background()
+	value, _ := q.Take(ctx)
+	assert.Equal(t, "now", value)
+	value, _ = q.Take(ctx)
+	assert.Equal(t, "early", value)
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+	value, _ = q.Take(ctx)
+	assert.Equal(t, "late", value)
+	assert.GreaterOrEqual(t, time.Since(start), 60*time.Millisecond)
+}
+
+func TestDelayQueue_EarlierElementWakesTaker(t *testing.T) {
+	q := blocking.NewDelay[int]()
+	q.Put(1, time.Hour)
+
+	result := make(chan int)
+	go func() {
+		value, _ := q.Take(context.Background())
+		result <- value
+	}()
+	time.Sleep(10 * time.Millisecond)
+	q.PutAt(2, time.Now())
+
+	select {
+	case value := <-result:
+		assert.Equal(t, 2, value)
+	case <-time.After(time.Second):
+		t.Fatal("Take did not notice the earlier element")
+	}
+}
+
+func TestDelayQueue_PollAndDrainSkipPendingElements(t *testing.T) {
+	q := blocking.NewDelay[int]()
+	q.Put(1, 0)
+	q.Put(2, 0)
+	q.Put(3, time.Hour)
+
+	_, ok := q.Poll(10 * time.Millisecond)
+	assert.True(t, ok)
+	assert.Equal(t, []int{2}, q.Drain(0))
+	_, ok = q.Poll(10 * time.Millisecond)
+	assert.False(t, ok)
+	assert.Equal(t, 1, q.Len())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := q.Take(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestDelayQueue_ConcurrentAccess(t *testing.T) {
+	q := blocking.NewDelay[int]()
+	var wg sync.WaitGroup
+	for p := 0; p < 4; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				q.Put(p*100+i, time.Duration(i%5)*time.Millisecond)
+			}
+		}(p)
+	}
+	var mu sync.Mutex
+	seen := make(map[int]bool)
+	for c := 0; c < 4; c++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				value, err := q.Take(context.Background())
+				assert.NoError(t, err)
+				mu.Lock()
+				seen[value] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Len(t, seen, 400)
+}
@@ -0,0 +1,83 @@
+package blocking
+
+import (
+	"cmp"
+	"context"
+	"time"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/heap/binaryheap"
+)
+
+// ordered stores elements in a binary heap.
+type ordered[T any] struct {
+	heap *binaryheap.Heap[T]
+}
+
+func (s ordered[T]) push(value T) {
+	s.heap.Push(value)
+}
+
+func (s ordered[T]) pop() T {
+	value, _ := s.heap.Pop()
+	return value
+}
+
+func (s ordered[T]) len() int {
+	return s.heap.Len()
+}
+
+func (s ordered[T]) delay() time.Duration {
+	return 0
+}
+
+// PriorityBlockingQueue is an unbounded thread-safe priority queue. Take
+// returns the smallest element under the comparator, waiting for one if
+// the queue is empty.
+type PriorityBlockingQueue[T any] struct {
+	core *core[T]
+}
+
+// NewPriority creates an empty PriorityBlockingQueue ordered by compare.
+func NewPriority[T any](compare func(a, b T) int) *PriorityBlockingQueue[T] {
+	return &PriorityBlockingQueue[T]{core: newCore[T](ordered[T]{binaryheap.New(compare)}, 0)}
+}
+
+// NewPriorityOrdered creates an empty PriorityBlockingQueue using the
+// natural order of T.
+func NewPriorityOrdered[T cmp.Ordered]() *PriorityBlockingQueue[T] {
+	return NewPriority[T](cmp.Compare[T])
+}
+
+// Put adds value. The queue is unbounded, so Put never waits and always
+// succeeds; it takes a context to match BlockingQueue.
+func (q *PriorityBlockingQueue[T]) Put(ctx context.Context, value T) error {
+	return q.core.put(ctx, value)
+}
+
+// Take removes and returns the smallest element, waiting for one if the
+// queue is empty. It returns ctx.Err() if ctx is done first.
+func (q *PriorityBlockingQueue[T]) Take(ctx context.Context) (T, error) {
+	return q.core.take(ctx)
+}
+
+// Offer adds value and reports true; the queue never runs out of room.
+func (q *PriorityBlockingQueue[T]) Offer(value T, timeout time.Duration) bool {
+	return q.core.offer(value, timeout)
+}
+
+// Poll removes and returns the smallest element, waiting up to timeout
+// for one. A timeout of zero or less does not wait.
+func (q *PriorityBlockingQueue[T]) Poll(timeout time.Duration) (T, bool) {
+	return q.core.poll(timeout)
+}
+
+// Drain removes and returns up to limit elements in priority order
+// without waiting. A limit of zero or less drains the whole queue.
+func (q *PriorityBlockingQueue[T]) Drain(limit int) []T {
+	return q.core.drain(limit)
+}
+
+// Len returns the number of elements in the queue.
+func (q *PriorityBlockingQueue[T]) Len() int {
+	return q.core.len()
+}
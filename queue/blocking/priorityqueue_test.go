@@ -0,0 +1,77 @@
+package blocking_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/queue/blocking"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriorityBlockingQueue_TakesInOrder(t *testing.T) {
+	q := blocking.NewPriorityOrdered[int]()
+	ctx := context.Background()
+	for _, v := range []int{5, 1, 4, 2, 3} {
+		assert.NoError(t, q.Put(ctx, v))
+	}
+	assert.True(t, q.Offer(0, 0))
+	assert.Equal(t, 6, q.Len())
+
+	value, _ := q.Take(ctx)
+	assert.Equal(t, 0, value)
+	value, _ = q.Poll(0)
+	assert.Equal(t, 1, value)
+	assert.Equal(t, []int{2, 3}, q.Drain(2))
+	assert.Equal(t, []int{4, 5}, q.Drain(0))
+}
+
+func TestPriorityBlockingQueue_TakeWaits(t *testing.T) {
+	q := blocking.NewPriority(func(a, b string) int { return len(a) - len(b) })
+	result := make(chan string)
+	go func() {
+		value, _ := q.Take(context.Background())
+		result <- value
+	}()
+	time.Sleep(10 * time.Millisecond)
+	_ = q.Put(context.Background(), "wake")
+	assert.Equal(t, "wake", <-result)
+
+	_, ok := q.Poll(10 * time.Millisecond)
+	assert.False(t, ok)
+}
+
+func TestPriorityBlockingQueue_ConcurrentAccess(t *testing.T) {
+	q := blocking.NewPriorityOrdered[int]()
+	var wg sync.WaitGroup
+	for p := 0; p < 4; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < 250; i++ {
+				_ = q.Put(context.Background(), p*250+i)
+			}
+		}(p)
+	}
+	taken := make(chan int, 1000)
+	for c := 0; c < 4; c++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 250; i++ {
+				value, err := q.Take(context.Background())
+				assert.NoError(t, err)
+				taken <- value
+			}
+		}()
+	}
+	wg.Wait()
+	close(taken)
+
+	seen := make(map[int]bool)
+	for value := range taken {
+		seen[value] = true
+	}
+	assert.Len(t, seen, 1000)
+}
@@ -0,0 +1,271 @@
+package deque
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OverflowPolicy decides what a bounded Deque does when it is full.
+type OverflowPolicy int
+
+const (
+	// Reject refuses the new element and leaves the deque unchanged.
+	Reject OverflowPolicy = iota
+	// Overwrite drops the element at the opposite end to make room.
+	Overwrite
+)
+
+// minCapacity is the smallest backing array a growing Deque allocates.
+const minCapacity = 4
+
+// Deque is a double-ended queue on a growable ring buffer. Pushing and
+// popping at either end is O(1) amortized and elements can be read or
+// replaced by index. A bounded Deque never holds more than its limit and
+// handles overflow according to its OverflowPolicy.
+type Deque[T any] struct {
+	buf    []T
+	head   int
+	size   int
+	limit  int
+	policy OverflowPolicy
+}
+
+// New creates an empty, unbounded Deque.
+func New[T any]() *Deque[T] {
+	return NewWithCapacity[T](0)
+}
+
+// NewWithCapacity creates an empty, unbounded Deque with room for
+// capacity elements.
+func NewWithCapacity[T any](capacity int) *Deque[T] {
+	return &Deque[T]{buf: make([]T, max(capacity, 0))}
+}
+
+// NewBounded creates an empty Deque holding at most limit elements.
+// It panics if limit is not positive.
+func NewBounded[T any](limit int, policy OverflowPolicy) *Deque[T] {
+	if limit <= 0 {
+		panic(fmt.Sprintf("deque: invalid limit %d", limit))
+	}
+	return &Deque[T]{buf: make([]T, limit), limit: limit, policy: policy}
+}
+
+// PushBack appends value at the back. It returns false if the deque is
+// bounded, full and rejects new elements.
+func (d *Deque[T]) PushBack(value T) bool {
+	if d.IsFull() {
+		if d.policy == Reject {
+			return false
+		}
+		d.PopFront()
+	}
+	d.grow()
+	d.buf[d.index(d.size)] = value
+	d.size++
+	return true
+}
+
+// PushFront prepends value at the front. It returns false if the deque is
+// bounded, full and rejects new elements.
+func (d *Deque[T]) PushFront(value T) bool {
+	if d.IsFull() {
+		if d.policy == Reject {
+			return false
+		}
+		d.PopBack()
+	}
+	d.grow()
+	d.head = d.index(len(d.buf) - 1)
+	d.buf[d.head] = value
+	d.size++
+	return true
+}
+
+// PopFront removes and returns the front element.
+func (d *Deque[T]) PopFront() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+	value := d.buf[d.head]
+	d.buf[d.head] = zero
+	d.head = d.index(1)
+	d.size--
+	return value, true
+}
+
+// PopBack removes and returns the back element.
+func (d *Deque[T]) PopBack() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+	i := d.index(d.size - 1)
+	value := d.buf[i]
+	d.buf[i] = zero
+	d.size--
+	return value, true
+}
+
+// Front returns the front element without removing it.
+func (d *Deque[T]) Front() (T, bool) {
+	if d.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.head], true
+}
+
+// Back returns the back element without removing it.
+func (d *Deque[T]) Back() (T, bool) {
+	if d.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.index(d.size-1)], true
+}
+
+// Get returns the element at index, counting from the front.
+func (d *Deque[T]) Get(index int) T {
+	d.check(index)
+	return d.buf[d.index(index)]
+}
+
+// Set replaces the element at index and returns the previous one.
+func (d *Deque[T]) Set(index int, value T) T {
+	d.check(index)
+	i := d.index(index)
+	old := d.buf[i]
+	d.buf[i] = value
+	return old
+}
+
+// Rotate moves the last n elements to the front, or the first -n elements
+// to the back when n is negative. It costs O(min(n, Len()-n)).
+func (d *Deque[T]) Rotate(n int) {
+	if d.size < 2 {
+		return
+	}
+	n %= d.size
+	if n < 0 {
+		n += d.size
+	}
+	if n == 0 {
+		return
+	}
+	if d.size == len(d.buf) {
+		// The ring is full, so only the start moves
+		d.head = d.index(len(d.buf) - n)
+		return
+	}
+	if n <= d.size/2 {
+		for ; n > 0; n-- {
+			back := d.index(d.size - 1)
+			d.head = d.index(len(d.buf) - 1)
+			d.buf[d.head], d.buf[back] = d.buf[back], d.buf[d.head]
+		}
+		return
+	}
+	for n = d.size - n; n > 0; n-- {
+		end := d.index(d.size)
+		d.buf[end], d.buf[d.head] = d.buf[d.head], d.buf[end]
+		d.head = d.index(1)
+	}
+}
+
+// Len returns the number of elements in the deque.
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+// Capacity returns the number of elements the deque can hold without growing.
+func (d *Deque[T]) Capacity() int {
+	return len(d.buf)
+}
+
+// Limit returns the maximum size of a bounded deque, or 0 if it is unbounded.
+func (d *Deque[T]) Limit() int {
+	return d.limit
+}
+
+// IsEmpty checks if the deque is empty.
+func (d *Deque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+// IsFull checks if a bounded deque has reached its limit. An unbounded
+// deque is never full.
+func (d *Deque[T]) IsFull() bool {
+	return d.limit > 0 && d.size == d.limit
+}
+
+// Clear removes all elements but keeps the capacity.
+func (d *Deque[T]) Clear() {
+	clear(d.buf)
+	d.head, d.size = 0, 0
+}
+
+// Each calls fn for every element from front to back until fn returns false.
+func (d *Deque[T]) Each(fn func(index int, value T) bool) {
+	for i := 0; i < d.size; i++ {
+		if !fn(i, d.buf[d.index(i)]) {
+			return
+		}
+	}
+}
+
+// Values returns a slice of all elements from front to back.
+func (d *Deque[T]) Values() []T {
+	values := make([]T, d.size)
+	d.copyTo(values)
+	return values
+}
+
+// ToString returns a string representation of the deque.
+func (d *Deque[T]) ToString() string {
+	var sb strings.Builder
+	sb.WriteString("[")
+	for i := 0; i < d.size; i++ {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v", d.buf[d.index(i)]))
+	}
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// index maps a position relative to the front onto the backing array.
+func (d *Deque[T]) index(offset int) int {
+	i := d.head + offset
+	if i >= len(d.buf) {
+		i -= len(d.buf)
+	}
+	return i
+}
+
+// grow doubles the backing array if there is no room for one more element.
+func (d *Deque[T]) grow() {
+	if d.size < len(d.buf) {
+		return
+	}
+	buf := make([]T, max(2*len(d.buf), minCapacity))
+	d.copyTo(buf)
+	d.buf, d.head = buf, 0
+}
+
+// copyTo copies the elements in order into dst.
+func (d *Deque[T]) copyTo(dst []T) {
+	if d.size == 0 {
+		return
+	}
+	n := copy(dst, d.buf[d.head:min(d.head+d.size, len(d.buf))])
+	copy(dst[n:], d.buf[:d.size-n])
+}
+
+// check panics if index does not address an element.
+func (d *Deque[T]) check(index int) {
+	if index < 0 || index >= d.size {
+		panic(fmt.Sprintf("deque: index %d out of range with size %d", index, d.size))
+	}
+}
@@ -0,0 +1,163 @@
+package deque_test
+
+import (
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/queue/deque"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeque_PushPopBothEnds(t *testing.T) {
+	d := deque.New[int]()
+	_, ok := d.PopFront()
+	assert.False(t, ok)
+
+	for i := 0; i < 10; i++ {
+		d.PushBack(i)
+		d.PushFront(-i - 1)
+	}
+	assert.Equal(t, 20, d.Len())
+	assert.Equal(t, []int{-10, -9, -8, -7, -6, -5, -4, -3, -2, -1, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, d.Values())
+
+	front, _ := d.Front()
+	back, _ := d.Back()
+	assert.Equal(t, -10, front)
+	assert.Equal(t, 9, back)
+
+	for i := 9; i >= 0; i-- {
+		value, ok := d.PopBack()
+		assert.True(t, ok)
+		assert.Equal(t, i, value)
+	}
+	value, _ := d.PopFront()
+	assert.Equal(t, -10, value)
+	assert.Equal(t, 9, d.Len())
+	assert.Equal(t, "[-9, -8, -7, -6, -5, -4, -3, -2, -1]", d.ToString())
+
+	d.Clear()
+	assert.True(t, d.IsEmpty())
+	_, ok = d.Back()
+	assert.False(t, ok)
+}
+
+func TestDeque_IndexAccessAcrossWrap(t *testing.T) {
+	d := deque.NewWithCapacity[string](4)
+	d.PushBack("c")
+	d.PushBack("d")
+	d.PushFront("b")
+	d.PushFront("a")
+	assert.Equal(t, 4, d.Capacity())
+
+	assert.Equal(t, "a", d.Get(0))
+	assert.Equal(t, "d", d.Get(3))
+	assert.Equal(t, "b", d.Set(1, "B"))
+	assert.Equal(t, []string{"a", "B", "c", "d"}, d.Values())
+
+	assert.PanicsWithValue(t, "deque: index 4 out of range with size 4", func() { d.Get(4) })
+	assert.Panics(t, func() { d.Set(-1, "x") })
+
+	seen := 0
+	d.Each(func(index int, value string) bool {
+		seen++
+		return index < 1
+	})
+	assert.Equal(t, 2, seen)
+}
+
+func TestDeque_Rotate(t *testing.T) {
+	for _, capacity := range []int{5, 16} {
+		d := deque.NewWithCapacity[int](capacity)
+		for i := 1; i <= 5; i++ {
+			d.PushBack(i)
+		}
+
+		d.Rotate(2)
+		assert.Equal(t, []int{4, 5, 1, 2, 3}, d.Values())
+		d.Rotate(-2)
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, d.Values())
+		d.Rotate(4)
+		assert.Equal(t, []int{2, 3, 4, 5, 1}, d.Values())
+		d.Rotate(-9)
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, d.Values())
+		d.Rotate(10)
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, d.Values())
+
+		d.PushBack(6)
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, d.Values())
+	}
+}
+
+func TestDeque_BoundedReject(t *testing.T) {
+	d := deque.NewBounded[int](3, deque.Reject)
+	assert.True(t, d.PushBack(1))
+	assert.True(t, d.PushBack(2))
+	assert.True(t, d.PushFront(0))
+	assert.True(t, d.IsFull())
+
+	assert.False(t, d.PushBack(3))
+	assert.False(t, d.PushFront(-1))
+	assert.Equal(t, []int{0, 1, 2}, d.Values())
+	assert.Equal(t, 3, d.Limit())
+	assert.Equal(t, 3, d.Capacity())
+}
+
+func TestDeque_BoundedOverwrite(t *testing.T) {
+	d := deque.NewBounded[int](3, deque.Overwrite)
+	for i := 0; i < 5; i++ {
+		assert.True(t, d.PushBack(i))
+	}
+	assert.Equal(t, []int{2, 3, 4}, d.Values())
+
+	d.PushFront(1)
+	assert.Equal(t, []int{1, 2, 3}, d.Values())
+	assert.Equal(t, 3, d.Capacity())
+}
+
+func TestNewBounded_InvalidLimit(t *testing.T) {
+	assert.Panics(t, func() { deque.NewBounded[int](0, deque.Reject) })
+}
+
+func TestStack(t *testing.T) {
+	s := deque.NewStack[int]()
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+	top, _ := s.Peek()
+	assert.Equal(t, 3, top)
+	value, _ := s.Pop()
+	assert.Equal(t, 3, value)
+	assert.Equal(t, []int{1, 2}, s.Values())
+	assert.Equal(t, 2, s.Len())
+
+	history := deque.NewBoundedStack[string](2, deque.Overwrite)
+	history.Push("a")
+	history.Push("b")
+	history.Push("c")
+	assert.Equal(t, []string{"b", "c"}, history.Values())
+
+	s.Clear()
+	assert.True(t, s.IsEmpty())
+	_, ok := s.Pop()
+	assert.False(t, ok)
+}
+
+func TestQueue(t *testing.T) {
+	q := deque.NewQueue[int]()
+	for i := 1; i <= 3; i++ {
+		q.Enqueue(i)
+	}
+	head, _ := q.Peek()
+	assert.Equal(t, 1, head)
+	value, _ := q.Dequeue()
+	assert.Equal(t, 1, value)
+	assert.Equal(t, []int{2, 3}, q.Values())
+
+	bounded := deque.NewBoundedQueue[int](2, deque.Reject)
+	assert.True(t, bounded.Enqueue(1))
+	assert.True(t, bounded.Enqueue(2))
+	assert.False(t, bounded.Enqueue(3))
+	assert.Equal(t, 2, bounded.Len())
+
+	q.Clear()
+	assert.True(t, q.IsEmpty())
+}
@@ -0,0 +1,54 @@
+package deque
+
+// Queue is a first-in, first-out queue backed by a Deque.
+// A bounded Queue with the Overwrite policy drops its oldest element
+// when full, keeping the most recent limit elements.
+type Queue[T any] struct {
+	deque *Deque[T]
+}
+
+// NewQueue creates an empty, unbounded Queue.
+func NewQueue[T any]() *Queue[T] {
+	return &Queue[T]{deque: New[T]()}
+}
+
+// NewBoundedQueue creates an empty Queue holding at most limit elements.
+func NewBoundedQueue[T any](limit int, policy OverflowPolicy) *Queue[T] {
+	return &Queue[T]{deque: NewBounded[T](limit, policy)}
+}
+
+// Enqueue adds value at the tail of the queue. It returns false if the
+// queue is bounded, full and rejects new elements.
+func (q *Queue[T]) Enqueue(value T) bool {
+	return q.deque.PushBack(value)
+}
+
+// Dequeue removes and returns the element at the head of the queue.
+func (q *Queue[T]) Dequeue() (T, bool) {
+	return q.deque.PopFront()
+}
+
+// Peek returns the element at the head without removing it.
+func (q *Queue[T]) Peek() (T, bool) {
+	return q.deque.Front()
+}
+
+// Len returns the number of elements in the queue.
+func (q *Queue[T]) Len() int {
+	return q.deque.Len()
+}
+
+// IsEmpty checks if the queue is empty.
+func (q *Queue[T]) IsEmpty() bool {
+	return q.deque.IsEmpty()
+}
+
+// Clear removes all elements from the queue.
+func (q *Queue[T]) Clear() {
+	q.deque.Clear()
+}
+
+// Values returns a slice of all elements from head to tail.
+func (q *Queue[T]) Values() []T {
+	return q.deque.Values()
+}
@@ -0,0 +1,54 @@
+package deque
+
+// Stack is a last-in, first-out stack backed by a Deque.
+// A bounded Stack with the Overwrite policy drops its bottom element
+// when full, which suits undo histories.
+type Stack[T any] struct {
+	deque *Deque[T]
+}
+
+// NewStack creates an empty, unbounded Stack.
+func NewStack[T any]() *Stack[T] {
+	return &Stack[T]{deque: New[T]()}
+}
+
+// NewBoundedStack creates an empty Stack holding at most limit elements.
+func NewBoundedStack[T any](limit int, policy OverflowPolicy) *Stack[T] {
+	return &Stack[T]{deque: NewBounded[T](limit, policy)}
+}
+
+// Push places value on top of the stack. It returns false if the stack
+// is bounded, full and rejects new elements.
+func (s *Stack[T]) Push(value T) bool {
+	return s.deque.PushBack(value)
+}
+
+// Pop removes and returns the top element.
+func (s *Stack[T]) Pop() (T, bool) {
+	return s.deque.PopBack()
+}
+
+// Peek returns the top element without removing it.
+func (s *Stack[T]) Peek() (T, bool) {
+	return s.deque.Back()
+}
+
+// Len returns the number of elements in the stack.
+func (s *Stack[T]) Len() int {
+	return s.deque.Len()
+}
+
+// IsEmpty checks if the stack is empty.
+func (s *Stack[T]) IsEmpty() bool {
+	return s.deque.IsEmpty()
+}
+
+// Clear removes all elements from the stack.
+func (s *Stack[T]) Clear() {
+	s.deque.Clear()
+}
+
+// Values returns a slice of all elements from bottom to top.
+func (s *Stack[T]) Values() []T {
+	return s.deque.Values()
+}
@@ -0,0 +1,175 @@
+package lockfree_test
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/queue/lockfree"
+	"github.com/stretchr/testify/assert"
+)
+
+// ring is the API shared by the lock-free queues.
+type ring[T any] interface {
+	Enqueue(value T) bool
+	Dequeue() (T, bool)
+	EnqueueBatch(values []T) int
+	DequeueBatch(dst []T) int
+	Len() int
+	Capacity() int
+}
+
+var rings = []struct {
+	name string
+	new  func(capacity int) ring[int]
+}{
+	{"MPMC", func(capacity int) ring[int] { return lockfree.NewMPMC[int](capacity) }},
+	{"MPSC", func(capacity int) ring[int] { return lockfree.NewMPSC[int](capacity) }},
+	{"SPSC", func(capacity int) ring[int] { return lockfree.NewSPSC[int](capacity) }},
+}
+
+func TestRing_SingleGoroutine(t *testing.T) {
+	for _, impl := range rings {
+		q := impl.new(3)
+		assert.Equal(t, 4, q.Capacity(), impl.name)
+		_, ok := q.Dequeue()
+		assert.False(t, ok, impl.name)
+
+		// Wrap around the ring several times
+		for lap := 0; lap < 5; lap++ {
+			for i := 0; i < 4; i++ {
+				assert.True(t, q.Enqueue(lap*10+i), impl.name)
+			}
+			assert.False(t, q.Enqueue(-1), impl.name)
+			assert.Equal(t, 4, q.Len(), impl.name)
+			for i := 0; i < 4; i++ {
+				value, ok := q.Dequeue()
+				assert.True(t, ok, impl.name)
+				assert.Equal(t, lap*10+i, value, impl.name)
+			}
+			assert.Equal(t, 0, q.Len(), impl.name)
+		}
+	}
+}
+
+func TestRing_Batches(t *testing.T) {
+	for _, impl := range rings {
+		q := impl.new(8)
+		assert.Equal(t, 5, q.EnqueueBatch([]int{1, 2, 3, 4, 5}), impl.name)
+		assert.Equal(t, 3, q.EnqueueBatch([]int{6, 7, 8, 9, 10}), impl.name)
+		assert.Equal(t, 0, q.EnqueueBatch([]int{11}), impl.name)
+
+		dst := make([]int, 3)
+		assert.Equal(t, 3, q.DequeueBatch(dst), impl.name)
+		assert.Equal(t, []int{1, 2, 3}, dst, impl.name)
+
+		// The freed cells are at the start of the ring, so this batch wraps
+		assert.Equal(t, 2, q.EnqueueBatch([]int{9, 10}), impl.name)
+		dst = make([]int, 16)
+		n := q.DequeueBatch(dst)
+		assert.Equal(t, []int{4, 5, 6, 7, 8, 9, 10}, dst[:n], impl.name)
+		assert.Equal(t, 0, q.DequeueBatch(dst), impl.name)
+	}
+}
+
+func TestNewMPMC_InvalidCapacity(t *testing.T) {
+	assert.PanicsWithValue(t, "lockfree: invalid capacity 0", func() { lockfree.NewMPMC[int](0) })
+}
+
+// stress runs producers that each enqueue perProducer increasing values
+// tagged with their id, and consumers that dequeue until every value was
+// seen. It checks that nothing is lost or duplicated and that each
+// consumer sees every producer's values in order.
+func stress(t *testing.T, q ring[int], producers, consumers, perProducer int, batch bool) {
+	total := producers * perProducer
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			values := make([]int, perProducer)
+			for i := range values {
+				values[i] = p*perProducer + i
+			}
+			for len(values) > 0 {
+				n := 1
+				if batch {
+					n = q.EnqueueBatch(values[:min(len(values), 7)])
+				} else if !q.Enqueue(values[0]) {
+					n = 0
+				}
+				if n == 0 {
+					runtime.Gosched()
+				}
+				values = values[n:]
+			}
+		}(p)
+	}
+
+	var mu sync.Mutex
+	seen := make([]int, total)
+	remaining := total
+	for c := 0; c < consumers; c++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			last := make([]int, producers)
+			for i := range last {
+				last[i] = -1
+			}
+			dst := make([]int, 5)
+			for {
+				mu.Lock()
+				done := remaining == 0
+				mu.Unlock()
+				if done {
+					return
+				}
+				n := 0
+				if batch {
+					n = q.DequeueBatch(dst)
+				} else if value, ok := q.Dequeue(); ok {
+					dst[0], n = value, 1
+				}
+				if n == 0 {
+					runtime.Gosched()
+					continue
+				}
+				mu.Lock()
+				for _, value := range dst[:n] {
+					p := value / perProducer
+					if value <= last[p] {
+						t.Errorf("value %d of producer %d seen after %d", value, p, last[p])
+					}
+					last[p] = value
+					seen[value]++
+					remaining--
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	for value, count := range seen {
+		if count != 1 {
+			t.Fatalf("value %d dequeued %d times", value, count)
+		}
+	}
+	assert.Equal(t, 0, q.Len())
+}
+
+func TestMPMC_ConcurrentAccess(t *testing.T) {
+	stress(t, lockfree.NewMPMC[int](16), 4, 4, 5000, false)
+	stress(t, lockfree.NewMPMC[int](16), 4, 4, 5000, true)
+}
+
+func TestMPSC_ConcurrentAccess(t *testing.T) {
+	stress(t, lockfree.NewMPSC[int](16), 4, 1, 5000, false)
+	stress(t, lockfree.NewMPSC[int](16), 4, 1, 5000, true)
+}
+
+func TestSPSC_ConcurrentAccess(t *testing.T) {
+	stress(t, lockfree.NewSPSC[int](16), 1, 1, 20000, false)
+	stress(t, lockfree.NewSPSC[int](16), 1, 1, 20000, true)
+}
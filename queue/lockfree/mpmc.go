@@ -0,0 +1,89 @@
+package lockfree
+
+import "sync/atomic"
+
+// MPMC is a bounded lock-free queue for any number of producers and
+// consumers, after Dmitry Vyukov's sequence-numbered ring. Each cell
+// carries a sequence number, so producers and consumers only contend on
+// their own position counter and never on each other.
+type MPMC[T any] struct {
+	_       cacheLinePad
+	enqueue atomic.Uint64
+	_       cacheLinePad
+	dequeue atomic.Uint64
+	_       cacheLinePad
+	cells   []cell[T]
+	mask    uint64
+}
+
+// NewMPMC creates an empty MPMC queue holding at least capacity elements;
+// the capacity is rounded up to a power of two.
+// It panics if capacity is not positive.
+func NewMPMC[T any](capacity int) *MPMC[T] {
+	q := &MPMC[T]{}
+	q.cells, q.mask = newCells[T](capacity)
+	return q
+}
+
+// Enqueue adds value at the tail and reports false if the queue is full.
+func (q *MPMC[T]) Enqueue(value T) bool {
+	pos, n := claimEnqueue(q.cells, q.mask, &q.enqueue, 1)
+	if n == 0 {
+		return false
+	}
+	c := &q.cells[pos&q.mask]
+	c.value = value
+	c.seq.Store(pos + 1)
+	return true
+}
+
+// Dequeue removes and returns the head and reports false if the queue
+// is empty.
+func (q *MPMC[T]) Dequeue() (T, bool) {
+	var zero T
+	pos, n := claimDequeue(q.cells, q.mask, &q.dequeue, 1)
+	if n == 0 {
+		return zero, false
+	}
+	c := &q.cells[pos&q.mask]
+	value := c.value
+	c.value = zero
+	c.seq.Store(pos + q.mask + 1)
+	return value, true
+}
+
+// EnqueueBatch adds as many leading elements of values as fit, claiming
+// their cells at once, and returns how many were added.
+func (q *MPMC[T]) EnqueueBatch(values []T) int {
+	start, n := claimEnqueue(q.cells, q.mask, &q.enqueue, len(values))
+	publish(q.cells, q.mask, start, values[:n])
+	return n
+}
+
+// DequeueBatch removes up to len(dst) elements into dst and returns how
+// many were removed.
+func (q *MPMC[T]) DequeueBatch(dst []T) int {
+	start, n := claimDequeue(q.cells, q.mask, &q.dequeue, len(dst))
+	consume(q.cells, q.mask, start, dst[:n])
+	return n
+}
+
+// Len returns the number of elements in the queue. Under concurrent use
+// it is only a snapshot.
+func (q *MPMC[T]) Len() int {
+	return length(q.enqueue.Load(), q.dequeue.Load(), q.mask)
+}
+
+// Capacity returns the number of elements the queue can hold.
+func (q *MPMC[T]) Capacity() int {
+	return int(q.mask + 1)
+}
+
+// length clamps the distance between the two counters, which may be read
+// at different moments, to the ring size.
+func length(enqueue, dequeue, mask uint64) int {
+	if enqueue <= dequeue {
+		return 0
+	}
+	return int(min(enqueue-dequeue, mask+1))
+}
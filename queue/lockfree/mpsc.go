@@ -0,0 +1,85 @@
+package lockfree
+
+import "sync/atomic"
+
+// MPSC is a bounded lock-free queue for any number of producers and a
+// single consumer goroutine. Producers claim cells like in MPMC, while the
+// consumer owns the head and advances it without compare-and-swap.
+type MPSC[T any] struct {
+	_       cacheLinePad
+	enqueue atomic.Uint64
+	_       cacheLinePad
+	dequeue atomic.Uint64
+	_       cacheLinePad
+	cells   []cell[T]
+	mask    uint64
+}
+
+// NewMPSC creates an empty MPSC queue holding at least capacity elements;
+// the capacity is rounded up to a power of two.
+// It panics if capacity is not positive.
+func NewMPSC[T any](capacity int) *MPSC[T] {
+	q := &MPSC[T]{}
+	q.cells, q.mask = newCells[T](capacity)
+	return q
+}
+
+// Enqueue adds value at the tail and reports false if the queue is full.
+func (q *MPSC[T]) Enqueue(value T) bool {
+	pos, n := claimEnqueue(q.cells, q.mask, &q.enqueue, 1)
+	if n == 0 {
+		return false
+	}
+	c := &q.cells[pos&q.mask]
+	c.value = value
+	c.seq.Store(pos + 1)
+	return true
+}
+
+// Dequeue removes and returns the head and reports false if the queue is
+// empty. Only the consumer goroutine may call it.
+func (q *MPSC[T]) Dequeue() (T, bool) {
+	var zero T
+	pos := q.dequeue.Load()
+	c := &q.cells[pos&q.mask]
+	if c.seq.Load() != pos+1 {
+		return zero, false
+	}
+	value := c.value
+	c.value = zero
+	c.seq.Store(pos + q.mask + 1)
+	q.dequeue.Store(pos + 1)
+	return value, true
+}
+
+// EnqueueBatch adds as many leading elements of values as fit, claiming
+// their cells at once, and returns how many were added.
+func (q *MPSC[T]) EnqueueBatch(values []T) int {
+	start, n := claimEnqueue(q.cells, q.mask, &q.enqueue, len(values))
+	publish(q.cells, q.mask, start, values[:n])
+	return n
+}
+
+// DequeueBatch removes up to len(dst) published elements into dst and
+// returns how many were removed. Only the consumer goroutine may call it.
+func (q *MPSC[T]) DequeueBatch(dst []T) int {
+	start := q.dequeue.Load()
+	n := 0
+	for n < len(dst) && q.cells[(start+uint64(n))&q.mask].seq.Load() == start+uint64(n)+1 {
+		n++
+	}
+	consume(q.cells, q.mask, start, dst[:n])
+	q.dequeue.Store(start + uint64(n))
+	return n
+}
+
+// Len returns the number of elements in the queue. Under concurrent use
+// it is only a snapshot.
+func (q *MPSC[T]) Len() int {
+	return length(q.enqueue.Load(), q.dequeue.Load(), q.mask)
+}
+
+// Capacity returns the number of elements the queue can hold.
+func (q *MPSC[T]) Capacity() int {
+	return int(q.mask + 1)
+}
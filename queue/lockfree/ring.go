@@ -0,0 +1,121 @@
+package lockfree
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// cacheLinePad keeps hot counters written by different goroutines on
+// separate cache lines.
+type cacheLinePad [64]byte
+
+// cell is a slot of a sequence-numbered ring. Its sequence tells whose
+// turn it is: seq == pos means free for the producer at pos, and
+// seq == pos+1 means filled for the consumer at pos.
+type cell[T any] struct {
+	seq   atomic.Uint64
+	value T
+}
+
+// newCells allocates a ring of at least capacity cells, rounded up to a
+// power of two, and returns it with its index mask.
+func newCells[T any](capacity int) ([]cell[T], uint64) {
+	size := ringSize(capacity)
+	cells := make([]cell[T], size)
+	for i := range cells {
+		cells[i].seq.Store(uint64(i))
+	}
+	return cells, uint64(size - 1)
+}
+
+// ringSize rounds capacity up to a power of two of at least 2.
+// It panics if capacity is not positive.
+func ringSize(capacity int) int {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("lockfree: invalid capacity %d", capacity))
+	}
+	size := 2
+	for size < capacity {
+		size <<= 1
+	}
+	return size
+}
+
+// claimEnqueue reserves up to n consecutive free cells for producers that
+// compete through pos. It returns the first reserved position and how
+// many were reserved, which is zero if the ring is full.
+func claimEnqueue[T any](cells []cell[T], mask uint64, pos *atomic.Uint64, n int) (uint64, int) {
+	for {
+		start := pos.Load()
+		free := 0
+		for free < n && free <= int(mask) {
+			p := start + uint64(free)
+			if cells[p&mask].seq.Load() != p {
+				break
+			}
+			free++
+		}
+		if free == 0 {
+			if cells[start&mask].seq.Load() < start {
+				// The consumer of the previous lap has not released the cell
+				return start, 0
+			}
+			// Another producer claimed start; retry with a fresh position
+			continue
+		}
+		if pos.CompareAndSwap(start, start+uint64(free)) {
+			return start, free
+		}
+	}
+}
+
+// claimDequeue reserves up to n consecutive filled cells for consumers
+// that compete through pos. It returns the first reserved position and how
+// many were reserved, which is zero if the ring is empty.
+func claimDequeue[T any](cells []cell[T], mask uint64, pos *atomic.Uint64, n int) (uint64, int) {
+	for {
+		start := pos.Load()
+		filled := 0
+		for filled < n && filled <= int(mask) {
+			p := start + uint64(filled)
+			if cells[p&mask].seq.Load() != p+1 {
+				break
+			}
+			filled++
+		}
+		if filled == 0 {
+			if cells[start&mask].seq.Load() < start+1 {
+				// The producer has not published the cell yet
+				return start, 0
+			}
+			// Another consumer claimed start; retry with a fresh position
+			continue
+		}
+		if pos.CompareAndSwap(start, start+uint64(filled)) {
+			return start, filled
+		}
+	}
+}
+
+// publish fills the cells reserved from start and hands them to consumers.
+func publish[T any](cells []cell[T], mask uint64, start uint64, values []T) {
+	for i, value := range values {
+		p := start + uint64(i)
+		c := &cells[p&mask]
+		c.value = value
+		c.seq.Store(p + 1)
+	}
+}
+
+// consume empties the cells reserved from start into dst and hands them
+// back to producers of the next lap.
+func consume[T any](cells []cell[T], mask uint64, start uint64, dst []T) {
+	var zero T
+	for i := range dst {
+		p := start + uint64(i)
+		c := &cells[p&mask]
+		dst[i] = c.value
+		c.value = zero
+		c.seq.Store(p + mask + 1)
+	}
+}
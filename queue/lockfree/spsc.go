@@ -0,0 +1,98 @@
+package lockfree
+
+import "sync/atomic"
+
+// SPSC is a bounded lock-free queue for exactly one producer goroutine
+// and one consumer goroutine. With a single writer per counter it needs no
+// compare-and-swap and no per-cell sequence numbers; each side caches the
+// other's counter and only rereads it when the ring looks full or empty.
+type SPSC[T any] struct {
+	_          cacheLinePad
+	tail       atomic.Uint64
+	cachedHead uint64
+	_          cacheLinePad
+	head       atomic.Uint64
+	cachedTail uint64
+	_          cacheLinePad
+	buf        []T
+	mask       uint64
+}
+
+// NewSPSC creates an empty SPSC queue holding at least capacity elements;
+// the capacity is rounded up to a power of two.
+// It panics if capacity is not positive.
+func NewSPSC[T any](capacity int) *SPSC[T] {
+	size := ringSize(capacity)
+	return &SPSC[T]{buf: make([]T, size), mask: uint64(size - 1)}
+}
+
+// Enqueue adds value at the tail and reports false if the queue is full.
+// Only the producer goroutine may call it.
+func (q *SPSC[T]) Enqueue(value T) bool {
+	tail := q.tail.Load()
+	if tail-q.cachedHead > q.mask {
+		if q.cachedHead = q.head.Load(); tail-q.cachedHead > q.mask {
+			return false
+		}
+	}
+	q.buf[tail&q.mask] = value
+	q.tail.Store(tail + 1)
+	return true
+}
+
+// Dequeue removes and returns the head and reports false if the queue is
+// empty. Only the consumer goroutine may call it.
+func (q *SPSC[T]) Dequeue() (T, bool) {
+	var zero T
+	head := q.head.Load()
+	if head == q.cachedTail {
+		if q.cachedTail = q.tail.Load(); head == q.cachedTail {
+			return zero, false
+		}
+	}
+	value := q.buf[head&q.mask]
+	q.buf[head&q.mask] = zero
+	q.head.Store(head + 1)
+	return value, true
+}
+
+// EnqueueBatch adds as many leading elements of values as fit, publishing
+// them at once, and returns how many were added. Only the producer
+// goroutine may call it.
+func (q *SPSC[T]) EnqueueBatch(values []T) int {
+	tail := q.tail.Load()
+	q.cachedHead = q.head.Load()
+	n := min(len(values), int(q.mask+1-(tail-q.cachedHead)))
+	for i := 0; i < n; i++ {
+		q.buf[(tail+uint64(i))&q.mask] = values[i]
+	}
+	q.tail.Store(tail + uint64(n))
+	return n
+}
+
+// DequeueBatch removes up to len(dst) elements into dst and returns how
+// many were removed. Only the consumer goroutine may call it.
+func (q *SPSC[T]) DequeueBatch(dst []T) int {
+	var zero T
+	head := q.head.Load()
+	q.cachedTail = q.tail.Load()
+	n := min(len(dst), int(q.cachedTail-head))
+	for i := 0; i < n; i++ {
+		j := (head + uint64(i)) & q.mask
+		dst[i] = q.buf[j]
+		q.buf[j] = zero
+	}
+	q.head.Store(head + uint64(n))
+	return n
+}
+
+// Len returns the number of elements in the queue. Under concurrent use
+// it is only a snapshot.
+func (q *SPSC[T]) Len() int {
+	return length(q.tail.Load(), q.head.Load(), q.mask)
+}
+
+// Capacity returns the number of elements the queue can hold.
+func (q *SPSC[T]) Capacity() int {
+	return int(q.mask + 1)
+}
@@ -0,0 +1,126 @@
+package workstealing
+
+import "sync/atomic"
+
+// minCapacity is the size of the first ring a Deque allocates.
+const minCapacity = 32
+
+// cacheLinePad keeps the counters written by the owner and by thieves on
+// separate cache lines.
+type cacheLinePad [64]byte
+
+// ring is a circular array of task pointers indexed by absolute position.
+type ring[T any] struct {
+	slots []atomic.Pointer[T]
+	mask  int64
+}
+
+func newRing[T any](size int64) *ring[T] {
+	return &ring[T]{slots: make([]atomic.Pointer[T], size), mask: size - 1}
+}
+
+func (r *ring[T]) get(i int64) *T {
+	return r.slots[i&r.mask].Load()
+}
+
+func (r *ring[T]) put(i int64, task *T) {
+	r.slots[i&r.mask].Store(task)
+}
+
+// grow returns a ring twice as large holding the elements in [top, bottom).
+func (r *ring[T]) grow(top, bottom int64) *ring[T] {
+	bigger := newRing[T](2 * (r.mask + 1))
+	for i := top; i < bottom; i++ {
+		bigger.put(i, r.get(i))
+	}
+	return bigger
+}
+
+// Deque is a Chase-Lev work-stealing deque. Its owner pushes and pops
+// tasks at the bottom like a stack, while any number of thieves steal the
+// oldest tasks from the top. Only the owner contends with thieves, and
+// only for the last task.
+//
+// The deque holds pointers so that an empty result can be reported as nil
+// and slots can be read and written atomically.
+type Deque[T any] struct {
+	_      cacheLinePad
+	top    atomic.Int64
+	_      cacheLinePad
+	bottom atomic.Int64
+	_      cacheLinePad
+	ring   atomic.Pointer[ring[T]]
+}
+
+// New creates an empty Deque.
+func New[T any]() *Deque[T] {
+	d := &Deque[T]{}
+	d.ring.Store(newRing[T](minCapacity))
+	return d
+}
+
+// Push adds task at the bottom, growing the ring if needed.
+// Only the owner may call it.
+func (d *Deque[T]) Push(task *T) {
+	b := d.bottom.Load()
+	t := d.top.Load()
+	r := d.ring.Load()
+	if b-t > r.mask {
+		r = r.grow(t, b)
+		d.ring.Store(r)
+	}
+	r.put(b, task)
+	d.bottom.Store(b + 1)
+}
+
+// Pop removes and returns the most recently pushed task, or nil if the
+// deque is empty or a thief took the last task first.
+// Only the owner may call it.
+func (d *Deque[T]) Pop() *T {
+	b := d.bottom.Load() - 1
+	r := d.ring.Load()
+	d.bottom.Store(b)
+	t := d.top.Load()
+	if t > b {
+		d.bottom.Store(b + 1)
+		return nil
+	}
+	task := r.get(b)
+	if t == b {
+		// Last task: race the thieves for it
+		if !d.top.CompareAndSwap(t, t+1) {
+			task = nil
+		}
+		d.bottom.Store(b + 1)
+		return task
+	}
+	r.put(b, nil)
+	return task
+}
+
+// Steal removes and returns the oldest task, or nil if the deque is empty
+// or another goroutine won the race for it; callers usually move on to
+// another victim. Any goroutine may call it.
+func (d *Deque[T]) Steal() *T {
+	t := d.top.Load()
+	b := d.bottom.Load()
+	if t >= b {
+		return nil
+	}
+	task := d.ring.Load().get(t)
+	if !d.top.CompareAndSwap(t, t+1) {
+		return nil
+	}
+	return task
+}
+
+// Len returns the number of tasks in the deque. Under concurrent use it
+// is only a snapshot.
+func (d *Deque[T]) Len() int {
+	return int(max(d.bottom.Load()-d.top.Load(), 0))
+}
+
+// IsEmpty checks if the deque has no tasks.
+func (d *Deque[T]) IsEmpty() bool {
+	return d.Len() == 0
+}
@@ -0,0 +1,102 @@
+package workstealing_test
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/queue/workstealing"
+	"github.com/stretchr/testify/assert"
+)
+
+func ptr(v int) *int {
+	return &v
+}
+
+func TestDeque_OwnerIsLIFOAndThievesFIFO(t *testing.T) {
+	d := workstealing.New[int]()
+	assert.Nil(t, d.Pop())
+	assert.Nil(t, d.Steal())
+
+	for i := 0; i < 5; i++ {
+		d.Push(ptr(i))
+	}
+	assert.Equal(t, 5, d.Len())
+	assert.Equal(t, 4, *d.Pop())
+	assert.Equal(t, 0, *d.Steal())
+	assert.Equal(t, 1, *d.Steal())
+	assert.Equal(t, 3, *d.Pop())
+	assert.Equal(t, 2, *d.Pop())
+	assert.Nil(t, d.Pop())
+	assert.True(t, d.IsEmpty())
+}
+
+func TestDeque_Grows(t *testing.T) {
+	d := workstealing.New[int]()
+	// Steal a few first so the live range wraps before growing
+	for i := 0; i < 10; i++ {
+		d.Push(ptr(-1))
+		d.Steal()
+	}
+	for i := 0; i < 1000; i++ {
+		d.Push(ptr(i))
+	}
+	assert.Equal(t, 1000, d.Len())
+	for i := 0; i < 500; i++ {
+		assert.Equal(t, i, *d.Steal())
+	}
+	for i := 999; i >= 500; i-- {
+		assert.Equal(t, i, *d.Pop())
+	}
+}
+
+func TestDeque_ConcurrentStealing(t *testing.T) {
+	const tasks, thieves = 20000, 3
+	d := workstealing.New[int]()
+	taken := make([]atomic.Int32, tasks)
+	var remaining atomic.Int64
+	remaining.Store(tasks)
+
+	var wg sync.WaitGroup
+	for i := 0; i < thieves; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for remaining.Load() > 0 {
+				if task := d.Steal(); task != nil {
+					taken[*task].Add(1)
+					remaining.Add(-1)
+				} else {
+					runtime.Gosched()
+				}
+			}
+		}()
+	}
+
+	// The owner pushes in bursts and pops part of each burst back
+	for i := 0; i < tasks; i++ {
+		d.Push(ptr(i))
+		if i%3 == 2 {
+			if task := d.Pop(); task != nil {
+				taken[*task].Add(1)
+				remaining.Add(-1)
+			}
+		}
+	}
+	for remaining.Load() > 0 {
+		if task := d.Pop(); task != nil {
+			taken[*task].Add(1)
+			remaining.Add(-1)
+		} else {
+			runtime.Gosched()
+		}
+	}
+	wg.Wait()
+
+	for i := range taken {
+		if n := taken[i].Load(); n != 1 {
+			t.Fatalf("task %d taken %d times", i, n)
+		}
+	}
+}
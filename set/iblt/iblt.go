@@ -0,0 +1,341 @@
+package iblt
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set"
+)
+
+// DefaultHashCount is the number of cells each key is stored in when
+// NewSketch is given a non-positive hash count.
+const DefaultHashCount = 3
+
+var (
+	// ErrSketchMismatch is returned when two sketches with different
+	// dimensions are combined.
+	ErrSketchMismatch = errors.New("iblt: sketches have different dimensions")
+
+	// ErrDecodeFailed is returned when a sketch cannot be fully peeled,
+	// which means the difference is too large for the sketch size.
+	ErrDecodeFailed = errors.New("iblt: sketch could not be fully decoded")
+
+	// ErrInvalidEncoding is returned when binary data does not describe a sketch.
+	ErrInvalidEncoding = errors.New("iblt: invalid sketch encoding")
+)
+
+// cell is a single bucket of the lookup table.
+type cell struct {
+	count   int64
+	lenSum  uint32
+	keySum  []byte
+	hashSum uint64
+}
+
+// Sketch is an invertible Bloom lookup table over element hashes.
+// Two peers encode their sets into sketches of the same dimensions,
+// exchange them and subtract one from the other; the result decodes
+// to the keys present on only one side.
+type Sketch struct {
+	cells     []cell
+	hashCount int
+}
+
+// NewSketch creates an empty sketch with at least size cells, each key
+// being stored in hashCount of them. The size is rounded up to a multiple
+// of hashCount. A sketch needs roughly 1.5 cells per differing element
+// to decode reliably.
+func NewSketch(size, hashCount int) *Sketch {
+	if hashCount <= 0 {
+		hashCount = DefaultHashCount
+	}
+	if size < hashCount {
+		size = hashCount
+	}
+	size = (size + hashCount - 1) / hashCount * hashCount
+	return &Sketch{
+		cells:     make([]cell, size),
+		hashCount: hashCount,
+	}
+}
+
+// Encode builds a sketch containing the hashes of all elements of s.
+func Encode[T set.Setable](s set.Set[T], size, hashCount int) *Sketch {
+	sketch := NewSketch(size, hashCount)
+	for _, value := range s.ToSlice() {
+		sketch.Insert(value.Hash())
+	}
+	return sketch
+}
+
+// Size returns the number of cells in the sketch.
+func (s *Sketch) Size() int {
+	return len(s.cells)
+}
+
+// HashCount returns the number of cells each key is stored in.
+func (s *Sketch) HashCount() int {
+	return s.hashCount
+}
+
+// Insert adds a key to the sketch.
+func (s *Sketch) Insert(key string) {
+	s.update(key, 1)
+}
+
+// Delete removes a key from the sketch. Deleting a key that was never
+// inserted is allowed and is what makes subtraction work.
+func (s *Sketch) Delete(key string) {
+	s.update(key, -1)
+}
+
+// IsEmpty checks if every cell of the sketch is empty, which after a
+// subtraction means the two encoded sets were equal.
+func (s *Sketch) IsEmpty() bool {
+	for i := range s.cells {
+		if !s.cells[i].isEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+// Subtract returns a new sketch holding s minus other.
+// Both sketches must have the same size and hash count.
+func (s *Sketch) Subtract(other *Sketch) (*Sketch, error) {
+	if len(s.cells) != len(other.cells) || s.hashCount != other.hashCount {
+		return nil, ErrSketchMismatch
+	}
+	result := s.Clone()
+	for i := range result.cells {
+		c := &result.cells[i]
+		o := &other.cells[i]
+		c.count -= o.count
+		c.lenSum ^= o.lenSum
+		c.hashSum ^= o.hashSum
+		c.keySum = xorBytes(c.keySum, o.keySum)
+	}
+	return result, nil
+}
+
+// Decode peels the sketch and returns the keys that were inserted but not
+// deleted (local) and the keys that were deleted but not inserted (remote).
+// For a sketch produced by a.Subtract(b) these are the keys only in a and
+// only in b respectively. If the sketch cannot be fully peeled the keys
+// recovered so far are returned together with ErrDecodeFailed.
+func (s *Sketch) Decode() (local, remote []string, err error) {
+	work := s.Clone()
+	queue := make([]int, 0, len(work.cells))
+	for i := range work.cells {
+		if work.isPure(i) {
+			queue = append(queue, i)
+		}
+	}
+	for len(queue) > 0 {
+		i := queue[0]
+		queue = queue[1:]
+		if !work.isPure(i) {
+			continue
+		}
+		c := &work.cells[i]
+		key := string(c.keySum[:c.lenSum])
+		sign := c.count
+		if sign > 0 {
+			local = append(local, key)
+		} else {
+			remote = append(remote, key)
+		}
+		for _, idx := range work.indexes(key) {
+			work.cells[idx].remove(key, sign, checksum(key))
+			if work.isPure(idx) {
+				queue = append(queue, idx)
+			}
+		}
+	}
+	if !work.IsEmpty() {
+		return local, remote, ErrDecodeFailed
+	}
+	return local, remote, nil
+}
+
+// Clone returns a deep copy of the sketch.
+func (s *Sketch) Clone() *Sketch {
+	clone := &Sketch{
+		cells:     make([]cell, len(s.cells)),
+		hashCount: s.hashCount,
+	}
+	for i, c := range s.cells {
+		clone.cells[i] = c
+		clone.cells[i].keySum = append([]byte(nil), c.keySum...)
+	}
+	return clone
+}
+
+// MarshalBinary encodes the sketch so that it can be sent to a peer.
+func (s *Sketch) MarshalBinary() ([]byte, error) {
+	buf := binary.AppendUvarint(nil, uint64(s.hashCount))
+	buf = binary.AppendUvarint(buf, uint64(len(s.cells)))
+	for _, c := range s.cells {
+		buf = binary.AppendVarint(buf, c.count)
+		buf = binary.AppendUvarint(buf, uint64(c.lenSum))
+		buf = binary.AppendUvarint(buf, c.hashSum)
+		buf = binary.AppendUvarint(buf, uint64(len(c.keySum)))
+		buf = append(buf, c.keySum...)
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary replaces the sketch with one decoded from data.
+func (s *Sketch) UnmarshalBinary(data []byte) error {
+	r := reader{data: data}
+	hashCount := r.uvarint()
+	size := r.uvarint()
+	if r.err != nil || hashCount == 0 || size == 0 || size%hashCount != 0 || size > uint64(len(data)) {
+		return ErrInvalidEncoding
+	}
+	cells := make([]cell, size)
+	for i := range cells {
+		cells[i].count = r.varint()
+		cells[i].lenSum = uint32(r.uvarint())
+		cells[i].hashSum = r.uvarint()
+		cells[i].keySum = r.bytes(r.uvarint())
+	}
+	if r.err != nil || len(r.data) != 0 {
+		return ErrInvalidEncoding
+	}
+	s.cells = cells
+	s.hashCount = int(hashCount)
+	return nil
+}
+
+// update adds sign copies of key to each of its cells.
+func (s *Sketch) update(key string, sign int64) {
+	sum := checksum(key)
+	for _, idx := range s.indexes(key) {
+		c := &s.cells[idx]
+		c.count += sign
+		c.lenSum ^= uint32(len(key))
+		c.hashSum ^= sum
+		c.keySum = xorBytes(c.keySum, []byte(key))
+	}
+}
+
+// indexes returns the cells a key maps to, one in each sub-table so that
+// a key never lands in the same cell twice.
+func (s *Sketch) indexes(key string) []int {
+	width := len(s.cells) / s.hashCount
+	indexes := make([]int, s.hashCount)
+	for i := range indexes {
+		indexes[i] = i*width + int(hashKey(byte(i), key)%uint64(width))
+	}
+	return indexes
+}
+
+// isPure reports whether cell i holds exactly one key.
+func (s *Sketch) isPure(i int) bool {
+	c := &s.cells[i]
+	if c.count != 1 && c.count != -1 {
+		return false
+	}
+	if int(c.lenSum) > len(c.keySum) {
+		return false
+	}
+	for _, b := range c.keySum[c.lenSum:] {
+		if b != 0 {
+			return false
+		}
+	}
+	return c.hashSum == checksum(string(c.keySum[:c.lenSum]))
+}
+
+// remove takes sign copies of key out of the cell.
+func (c *cell) remove(key string, sign int64, sum uint64) {
+	c.count -= sign
+	c.lenSum ^= uint32(len(key))
+	c.hashSum ^= sum
+	c.keySum = xorBytes(c.keySum, []byte(key))
+}
+
+// isEmpty reports whether the cell holds nothing.
+func (c *cell) isEmpty() bool {
+	if c.count != 0 || c.lenSum != 0 || c.hashSum != 0 {
+		return false
+	}
+	for _, b := range c.keySum {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// xorBytes XORs src into dst, growing dst with zero bytes as needed.
+func xorBytes(dst, src []byte) []byte {
+	for len(dst) < len(src) {
+		dst = append(dst, 0)
+	}
+	for i, b := range src {
+		dst[i] ^= b
+	}
+	return dst
+}
+
+// hashKey hashes key with the given seed using FNV-1a, so that sketches
+// built by different processes agree on cell positions.
+func hashKey(seed byte, key string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte{seed})
+	h.Write([]byte(key))
+	return h.Sum64()
+}
+
+// checksum returns the hash used to detect pure cells.
+func checksum(key string) uint64 {
+	return hashKey(0xff, key)
+}
+
+// reader is a small helper for decoding varint-encoded sketches.
+type reader struct {
+	data []byte
+	err  error
+}
+
+func (r *reader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.data)
+	if n <= 0 {
+		r.err = ErrInvalidEncoding
+		return 0
+	}
+	r.data = r.data[n:]
+	return v
+}
+
+func (r *reader) varint() int64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(r.data)
+	if n <= 0 {
+		r.err = ErrInvalidEncoding
+		return 0
+	}
+	r.data = r.data[n:]
+	return v
+}
+
+func (r *reader) bytes(n uint64) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n > uint64(len(r.data)) {
+		r.err = ErrInvalidEncoding
+		return nil
+	}
+	b := append([]byte(nil), r.data[:n]...)
+	r.data = r.data[n:]
+	return b
+}
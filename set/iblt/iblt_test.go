@@ -0,0 +1,119 @@
+package iblt_test
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set/hashset"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set/iblt"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+// peer is an in-memory service holding a set of IDs.
+type peer struct {
+	ids *hashset.HashSet[*mocks.MockSetable]
+}
+
+func newPeer(ids ...string) *peer {
+	p := &peer{ids: hashset.NewHashSet[*mocks.MockSetable]()}
+	for _, id := range ids {
+		p.ids.Add(mocks.NewMockSetable(id))
+	}
+	return p
+}
+
+// sketch encodes the peer's set the way it would be sent over the wire.
+func (p *peer) sketch(t *testing.T, size int) []byte {
+	data, err := iblt.Encode[*mocks.MockSetable](p.ids, size, 3).MarshalBinary()
+	assert.NoError(t, err)
+	return data
+}
+
+// reconcile decodes the remote sketch against the local set.
+func (p *peer) reconcile(t *testing.T, remoteData []byte, size int) (local, remote []string, err error) {
+	var remoteSketch iblt.Sketch
+	assert.NoError(t, remoteSketch.UnmarshalBinary(remoteData))
+	diff, err := iblt.Encode[*mocks.MockSetable](p.ids, size, 3).Subtract(&remoteSketch)
+	assert.NoError(t, err)
+	local, remote, err = diff.Decode()
+	sort.Strings(local)
+	sort.Strings(remote)
+	return local, remote, err
+}
+
+func TestSketch_ReconcilePeers(t *testing.T) {
+	shared := make([]string, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		shared = append(shared, fmt.Sprintf("id-%d", i))
+	}
+	alice := newPeer(append(shared, "alice-1", "alice-2")...)
+	bob := newPeer(append(shared, "bob-1", "bob-2", "bob-3")...)
+
+	local, remote, err := alice.reconcile(t, bob.sketch(t, 30), 30)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"alice-1", "alice-2"}, local)
+	assert.Equal(t, []string{"bob-1", "bob-2", "bob-3"}, remote)
+
+	local, remote, err = bob.reconcile(t, alice.sketch(t, 30), 30)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bob-1", "bob-2", "bob-3"}, local)
+	assert.Equal(t, []string{"alice-1", "alice-2"}, remote)
+}
+
+func TestSketch_EqualSets(t *testing.T) {
+	alice := newPeer("a", "b", "c")
+	bob := newPeer("c", "b", "a")
+
+	local, remote, err := alice.reconcile(t, bob.sketch(t, 12), 12)
+	assert.NoError(t, err)
+	assert.Empty(t, local)
+	assert.Empty(t, remote)
+}
+
+func TestSketch_VariableLengthKeys(t *testing.T) {
+	sketch := iblt.NewSketch(20, 3)
+	sketch.Insert("x")
+	sketch.Insert("a-much-longer-identifier")
+	sketch.Delete("mid-size")
+
+	local, remote, err := sketch.Decode()
+	sort.Strings(local)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a-much-longer-identifier", "x"}, local)
+	assert.Equal(t, []string{"mid-size"}, remote)
+}
+
+func TestSketch_DecodeFailsWhenTooSmall(t *testing.T) {
+	sketch := iblt.NewSketch(6, 3)
+	for i := 0; i < 50; i++ {
+		sketch.Insert(fmt.Sprintf("key-%d", i))
+	}
+
+	_, _, err := sketch.Decode()
+	assert.ErrorIs(t, err, iblt.ErrDecodeFailed)
+}
+
+func TestSketch_SubtractMismatch(t *testing.T) {
+	_, err := iblt.NewSketch(12, 3).Subtract(iblt.NewSketch(24, 3))
+	assert.ErrorIs(t, err, iblt.ErrSketchMismatch)
+}
+
+func TestSketch_Dimensions(t *testing.T) {
+	sketch := iblt.NewSketch(10, 0)
+	assert.Equal(t, iblt.DefaultHashCount, sketch.HashCount())
+	assert.Equal(t, 12, sketch.Size())
+	assert.True(t, sketch.IsEmpty())
+
+	sketch.Insert("a")
+	assert.False(t, sketch.IsEmpty())
+	sketch.Delete("a")
+	assert.True(t, sketch.IsEmpty())
+}
+
+func TestSketch_UnmarshalInvalid(t *testing.T) {
+	var sketch iblt.Sketch
+	assert.ErrorIs(t, sketch.UnmarshalBinary(nil), iblt.ErrInvalidEncoding)
+	assert.ErrorIs(t, sketch.UnmarshalBinary([]byte{3, 3, 1}), iblt.ErrInvalidEncoding)
+}
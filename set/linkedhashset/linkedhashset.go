@@ -1,37 +1,36 @@
 package linkedhashset
 
 import (
-	"container/list"
 	"fmt"
 	"strings"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/list/linkedlist"
 )
 
 // LinkedHashSet maintains insertion order of elements.
 type LinkedHashSet[T comparable] struct {
-	data  map[T]*list.Element
-	order *list.List
+	data  map[T]*linkedlist.DoublyNode[T]
+	order linkedlist.DoublyLinkedList[T]
 }
 
 // New initializes a new LinkedHashSet.
 func New[T comparable]() *LinkedHashSet[T] {
 	return &LinkedHashSet[T]{
-		data:  make(map[T]*list.Element),
-		order: list.New(),
+		data: make(map[T]*linkedlist.DoublyNode[T]),
 	}
 }
 
 // Add inserts a value into the LinkedHashSet.
 func (s *LinkedHashSet[T]) Add(value T) {
 	if _, exists := s.data[value]; !exists {
-		elem := s.order.PushBack(value)
-		s.data[value] = elem
+		s.data[value] = s.order.PushBack(value)
 	}
 }
 
 // Remove deletes a value from the LinkedHashSet.
 func (s *LinkedHashSet[T]) Remove(value T) {
-	if elem, exists := s.data[value]; exists {
-		s.order.Remove(elem)
+	if node, exists := s.data[value]; exists {
+		s.order.Remove(node)
 		delete(s.data, value)
 	}
 }
@@ -54,17 +53,13 @@ func (s *LinkedHashSet[T]) IsEmpty() bool {
 
 // Clear removes all elements from the LinkedHashSet.
 func (s *LinkedHashSet[T]) Clear() {
-	s.data = make(map[T]*list.Element)
-	s.order.Init()
+	s.data = make(map[T]*linkedlist.DoublyNode[T])
+	s.order.Clear()
 }
 
 // Values returns a slice of all elements in the LinkedHashSet.
 func (s *LinkedHashSet[T]) Values() []T {
-	values := make([]T, 0, len(s.data))
-	for elem := s.order.Front(); elem != nil; elem = elem.Next() {
-		values = append(values, elem.Value.(T))
-	}
-	return values
+	return s.order.Values()
 }
 
 // ToString returns a string representation of the LinkedHashSet.
@@ -72,11 +67,11 @@ func (s *LinkedHashSet[T]) ToString() string {
 	var sb strings.Builder
 	sb.WriteString("[")
 	first := true
-	for elem := s.order.Front(); elem != nil; elem = elem.Next() {
+	for node := s.order.Front(); node != nil; node = node.Next() {
 		if !first {
 			sb.WriteString(", ")
 		}
-		sb.WriteString(fmt.Sprintf("%v", elem.Value))
+		sb.WriteString(fmt.Sprintf("%v", node.Value))
 		first = false
 	}
 	sb.WriteString("]")
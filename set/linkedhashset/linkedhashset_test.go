@@ -1,7 +1,7 @@
 package linkedhashset_test
 
 import (
-	"github.com/alasgarovnamig/go-dsa-and-alogorithm/set/linkedhashset"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set/linkedhashset"
 	"testing"
 )
 
@@ -104,3 +104,30 @@ func TestLinkedHashSet_ToString(t *testing.T) {
 		t.Errorf("Unexpected string representation: %s", str)
 	}
 }
+
+func TestSyncLinkedHashSet_KeepsInsertionOrder(t *testing.T) {
+	set := linkedhashset.NewSync[int]()
+	for _, v := range []int{5, 1, 4, 2, 3} {
+		set.Add(v)
+	}
+	set.Remove(4)
+
+	values := set.Values()
+	expected := []int{5, 1, 2, 3}
+	if len(values) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, values)
+	}
+	for i := range expected {
+		if values[i] != expected[i] {
+			t.Fatalf("Expected %v, got %v", expected, values)
+		}
+	}
+	if set.ToString() != "SyncLinkedHashSet : [5, 1, 2, 3]" {
+		t.Errorf("Unexpected string %q", set.ToString())
+	}
+
+	set.Clear()
+	if !set.IsEmpty() || len(set.Values()) != 0 {
+		t.Errorf("Expected set to be empty after Clear")
+	}
+}
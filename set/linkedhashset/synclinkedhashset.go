@@ -1,24 +1,24 @@
 package linkedhashset
 
 import (
-	"container/list"
 	"fmt"
 	"strings"
 	"sync"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/list/linkedlist"
 )
 
 // SyncLinkedHashSet is a thread-safe version of LinkedHashSet.
 type SyncLinkedHashSet[T comparable] struct {
-	data  map[T]*list.Element
-	order *list.List
+	data  map[T]*linkedlist.DoublyNode[T]
+	order linkedlist.DoublyLinkedList[T]
 	mu    sync.RWMutex
 }
 
 // NewSync initializes a new SyncLinkedHashSet.
 func NewSync[T comparable]() *SyncLinkedHashSet[T] {
 	return &SyncLinkedHashSet[T]{
-		data:  make(map[T]*list.Element),
-		order: list.New(),
+		data: make(map[T]*linkedlist.DoublyNode[T]),
 	}
 }
 
@@ -27,8 +27,7 @@ func (s *SyncLinkedHashSet[T]) Add(value T) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if _, exists := s.data[value]; !exists {
-		elem := s.order.PushBack(value)
-		s.data[value] = elem
+		s.data[value] = s.order.PushBack(value)
 	}
 }
 
@@ -36,8 +35,8 @@ func (s *SyncLinkedHashSet[T]) Add(value T) {
 func (s *SyncLinkedHashSet[T]) Remove(value T) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	if elem, exists := s.data[value]; exists {
-		s.order.Remove(elem)
+	if node, exists := s.data[value]; exists {
+		s.order.Remove(node)
 		delete(s.data, value)
 	}
 }
@@ -57,36 +56,35 @@ func (s *SyncLinkedHashSet[T]) Size() int {
 	return len(s.data)
 }
 
+// IsEmpty checks if the SyncLinkedHashSet is empty.
 func (s *SyncLinkedHashSet[T]) IsEmpty() bool {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	return s.Size() == 0
+	return len(s.data) == 0
 }
+
+// Clear removes all elements from the SyncLinkedHashSet.
 func (s *SyncLinkedHashSet[T]) Clear() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.data = make(map[T]*list.Element)
+	s.data = make(map[T]*linkedlist.DoublyNode[T])
+	s.order.Clear()
 }
+
+// Values returns a slice of all elements in insertion order.
 func (s *SyncLinkedHashSet[T]) Values() []T {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	values := make([]T, s.Size())
-	count := 0
-	for item := range s.data {
-		values[count] = item
-		count++
-	}
-	return values
+	return s.order.Values()
 }
+
+// ToString returns a string representation of the SyncLinkedHashSet.
 func (s *SyncLinkedHashSet[T]) ToString() string {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	str := "SyncLinkedHashSet : ["
-	items := []string{}
-	for k := range s.data {
-		items = append(items, fmt.Sprintf("%v", k))
+	items := make([]string, 0, len(s.data))
+	for node := s.order.Front(); node != nil; node = node.Next() {
+		items = append(items, fmt.Sprintf("%v", node.Value))
 	}
-	str += strings.Join(items, ", ")
-	str += "]"
-	return str
+	return "SyncLinkedHashSet : [" + strings.Join(items, ", ") + "]"
 }
@@ -0,0 +1,366 @@
+package skiplistset
+
+import (
+	"math/bits"
+	"math/rand/v2"
+	"sync/atomic"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set"
+)
+
+// concurrentLevels bounds the height of a node; each level is reached
+// with probability 1/4.
+const concurrentLevels = 24
+
+// link is an immutable successor reference paired with the deletion mark
+// of the node that owns it. Replacing the whole link with one CAS updates
+// the pointer and the mark together.
+type link[T any] struct {
+	node   *cnode[T]
+	marked bool
+}
+
+type cnode[T any] struct {
+	value T
+	next  []atomic.Pointer[link[T]]
+	tail  bool
+}
+
+func newCNode[T any](value T, height int) *cnode[T] {
+	return &cnode[T]{value: value, next: make([]atomic.Pointer[link[T]], height)}
+}
+
+// casNext replaces the successor at level if it is still node with the
+// given mark.
+func (n *cnode[T]) casNext(level int, node *cnode[T], marked bool, newNode *cnode[T], newMarked bool) bool {
+	current := n.next[level].Load()
+	if current.node != node || current.marked != marked {
+		return false
+	}
+	return n.next[level].CompareAndSwap(current, &link[T]{node: newNode, marked: newMarked})
+}
+
+// ConcurrentSkipListSet is a lock-free sorted set that is safe for
+// concurrent use. Removal first marks a node's links, then searches
+// unlink marked nodes as they pass, so no operation ever blocks another.
+//
+// Add, Remove and Contains are linearizable. Size, the navigation methods
+// and the bulk methods are weakly consistent: they reflect some but not
+// necessarily all updates running at the same time.
+type ConcurrentSkipListSet[T set.Setable] struct {
+	head    *cnode[T]
+	compare func(a, b T) int
+	size    atomic.Int64
+}
+
+// NewConcurrentSkipListSet creates an empty ConcurrentSkipListSet ordered
+// by compare.
+func NewConcurrentSkipListSet[T set.Setable](compare func(a, b T) int) *ConcurrentSkipListSet[T] {
+	var zero T
+	tail := newCNode(zero, concurrentLevels)
+	tail.tail = true
+	head := newCNode(zero, concurrentLevels)
+	for i := range head.next {
+		tail.next[i].Store(&link[T]{})
+		head.next[i].Store(&link[T]{node: tail})
+	}
+	return &ConcurrentSkipListSet[T]{head: head, compare: compare}
+}
+
+// Add inserts one or more elements into the ConcurrentSkipListSet.
+func (s *ConcurrentSkipListSet[T]) Add(values ...T) {
+	for _, value := range values {
+		s.add(value)
+	}
+}
+
+// Remove deletes one or more elements from the ConcurrentSkipListSet.
+func (s *ConcurrentSkipListSet[T]) Remove(values ...T) {
+	for _, value := range values {
+		s.remove(value)
+	}
+}
+
+// Contains checks if all specified elements are in the set.
+// It never writes to the list.
+func (s *ConcurrentSkipListSet[T]) Contains(values ...T) bool {
+	for _, value := range values {
+		if !s.contains(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Size returns the number of elements in the ConcurrentSkipListSet.
+func (s *ConcurrentSkipListSet[T]) Size() int {
+	// Removal can be counted before the matching insertion
+	return max(int(s.size.Load()), 0)
+}
+
+// IsEmpty checks if the ConcurrentSkipListSet has no elements.
+func (s *ConcurrentSkipListSet[T]) IsEmpty() bool {
+	return s.first() == nil
+}
+
+// Clear removes the elements present when it starts.
+func (s *ConcurrentSkipListSet[T]) Clear() {
+	for n := s.first(); n != nil; n = s.next(n) {
+		s.remove(n.value)
+	}
+}
+
+// ToString returns a string representation of the set in order.
+func (s *ConcurrentSkipListSet[T]) ToString() string {
+	return toString("ConcurrentSkipListSet", s.ToSlice())
+}
+
+// ToSlice returns the elements in order.
+func (s *ConcurrentSkipListSet[T]) ToSlice() []T {
+	values := make([]T, 0, s.Size())
+	for n := s.first(); n != nil; n = s.next(n) {
+		values = append(values, n.value)
+	}
+	return values
+}
+
+// First returns the lowest element.
+func (s *ConcurrentSkipListSet[T]) First() (T, bool) {
+	return valueOf(s.first())
+}
+
+// Last returns the highest element.
+func (s *ConcurrentSkipListSet[T]) Last() (T, bool) {
+	var last *cnode[T]
+	for n := s.first(); n != nil; n = s.next(n) {
+		last = n
+	}
+	return valueOf(last)
+}
+
+// Floor returns the greatest element less than or equal to value.
+func (s *ConcurrentSkipListSet[T]) Floor(value T) (T, bool) {
+	return valueOf(s.floor(value, true))
+}
+
+// Ceiling returns the least element greater than or equal to value.
+func (s *ConcurrentSkipListSet[T]) Ceiling(value T) (T, bool) {
+	var preds, succs [concurrentLevels]*cnode[T]
+	s.find(value, &preds, &succs)
+	return valueOf(s.real(succs[0]))
+}
+
+// Lower returns the greatest element strictly less than value.
+func (s *ConcurrentSkipListSet[T]) Lower(value T) (T, bool) {
+	return valueOf(s.floor(value, false))
+}
+
+// Higher returns the least element strictly greater than value.
+func (s *ConcurrentSkipListSet[T]) Higher(value T) (T, bool) {
+	var preds, succs [concurrentLevels]*cnode[T]
+	if s.find(value, &preds, &succs) {
+		return valueOf(s.next(succs[0]))
+	}
+	return valueOf(s.real(succs[0]))
+}
+
+// PollFirst removes and returns the lowest element.
+func (s *ConcurrentSkipListSet[T]) PollFirst() (T, bool) {
+	for {
+		n := s.first()
+		if n == nil {
+			return valueOf(n)
+		}
+		// Another goroutine may take the same element first
+		if s.remove(n.value) {
+			return n.value, true
+		}
+	}
+}
+
+// PollLast removes and returns the highest element.
+func (s *ConcurrentSkipListSet[T]) PollLast() (T, bool) {
+	for {
+		value, ok := s.Last()
+		if !ok {
+			return value, false
+		}
+		if s.remove(value) {
+			return value, true
+		}
+	}
+}
+
+func randomHeight() int {
+	// Every two trailing zero bits add a level
+	return min(bits.TrailingZeros64(rand.Uint64())/2+1, concurrentLevels)
+}
+
+// less reports whether n sorts before value. The tail sorts after all.
+func (s *ConcurrentSkipListSet[T]) less(n *cnode[T], value T) bool {
+	return !n.tail && s.compare(n.value, value) < 0
+}
+
+// find fills preds and succs with the nodes around value on every level,
+// unlinking marked nodes on the way, and reports whether succs[0] holds
+// value.
+func (s *ConcurrentSkipListSet[T]) find(value T, preds, succs *[concurrentLevels]*cnode[T]) bool {
+retry:
+	for {
+		pred := s.head
+		var curr *cnode[T]
+		for level := concurrentLevels - 1; level >= 0; level-- {
+			curr = pred.next[level].Load().node
+			for {
+				succ := curr.next[level].Load()
+				for succ.marked {
+					if !pred.casNext(level, curr, false, succ.node, false) {
+						continue retry
+					}
+					curr = succ.node
+					succ = curr.next[level].Load()
+				}
+				if !s.less(curr, value) {
+					break
+				}
+				pred, curr = curr, succ.node
+			}
+			preds[level], succs[level] = pred, curr
+		}
+		return !curr.tail && s.compare(curr.value, value) == 0
+	}
+}
+
+func (s *ConcurrentSkipListSet[T]) add(value T) bool {
+	height := randomHeight()
+	var preds, succs [concurrentLevels]*cnode[T]
+	for {
+		if s.find(value, &preds, &succs) {
+			return false
+		}
+		n := newCNode(value, height)
+		for level := 0; level < height; level++ {
+			n.next[level].Store(&link[T]{node: succs[level]})
+		}
+		// Linking the bottom level is what adds the element
+		if !preds[0].casNext(0, succs[0], false, n, false) {
+			continue
+		}
+		s.size.Add(1)
+		for level := 1; level < height; level++ {
+			for {
+				current := n.next[level].Load()
+				if current.marked {
+					// Removed already; searches unlink what was linked
+					return true
+				}
+				if current.node != succs[level] &&
+					!n.next[level].CompareAndSwap(current, &link[T]{node: succs[level]}) {
+					continue
+				}
+				if preds[level].casNext(level, succs[level], false, n, false) {
+					break
+				}
+				s.find(value, &preds, &succs)
+			}
+		}
+		return true
+	}
+}
+
+func (s *ConcurrentSkipListSet[T]) remove(value T) bool {
+	var preds, succs [concurrentLevels]*cnode[T]
+	if !s.find(value, &preds, &succs) {
+		return false
+	}
+	victim := succs[0]
+	for level := len(victim.next) - 1; level >= 1; level-- {
+		for succ := victim.next[level].Load(); !succ.marked; succ = victim.next[level].Load() {
+			victim.casNext(level, succ.node, false, succ.node, true)
+		}
+	}
+	// Marking the bottom level is what removes the element; whoever marks
+	// it first wins
+	for {
+		succ := victim.next[0].Load()
+		if succ.marked {
+			return false
+		}
+		if victim.casNext(0, succ.node, false, succ.node, true) {
+			s.size.Add(-1)
+			s.find(value, &preds, &succs)
+			return true
+		}
+	}
+}
+
+func (s *ConcurrentSkipListSet[T]) contains(value T) bool {
+	pred := s.head
+	var curr *cnode[T]
+	for level := concurrentLevels - 1; level >= 0; level-- {
+		curr = pred.next[level].Load().node
+		for {
+			succ := curr.next[level].Load()
+			for succ.marked {
+				curr = succ.node
+				succ = curr.next[level].Load()
+			}
+			if !s.less(curr, value) {
+				break
+			}
+			pred, curr = curr, succ.node
+		}
+	}
+	return !curr.tail && s.compare(curr.value, value) == 0
+}
+
+// floor returns the greatest live node below value, or equal to it when
+// inclusive is set, or nil.
+func (s *ConcurrentSkipListSet[T]) floor(value T, inclusive bool) *cnode[T] {
+	var preds, succs [concurrentLevels]*cnode[T]
+	for {
+		found := s.find(value, &preds, &succs)
+		if found && inclusive {
+			return succs[0]
+		}
+		// Skipping forward from a removed predecessor could pass value,
+		// so search again instead
+		pred := preds[0]
+		if pred == s.head {
+			return nil
+		}
+		if !pred.next[0].Load().marked {
+			return pred
+		}
+	}
+}
+
+// first returns the lowest node that is not marked, or nil.
+func (s *ConcurrentSkipListSet[T]) first() *cnode[T] {
+	return s.real(s.head.next[0].Load().node)
+}
+
+// next returns the node after n at the bottom level that is not marked,
+// or nil.
+func (s *ConcurrentSkipListSet[T]) next(n *cnode[T]) *cnode[T] {
+	return s.real(n.next[0].Load().node)
+}
+
+// real skips marked nodes from n on and maps the sentinels to nil.
+func (s *ConcurrentSkipListSet[T]) real(n *cnode[T]) *cnode[T] {
+	for n != s.head && !n.tail && n.next[0].Load().marked {
+		n = n.next[0].Load().node
+	}
+	if n == s.head || n.tail {
+		return nil
+	}
+	return n
+}
+
+func valueOf[T any](n *cnode[T]) (T, bool) {
+	if n == nil {
+		var zero T
+		return zero, false
+	}
+	return n.value, true
+}
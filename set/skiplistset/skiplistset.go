@@ -0,0 +1,124 @@
+package skiplistset
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps/skiplist"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set"
+)
+
+// SkipListSet implements the SortedSet interface on a skip list.
+// Elements are kept in the order given by the comparator, which also
+// decides uniqueness in place of Hash.
+type SkipListSet[T set.Setable] struct {
+	list *skiplist.SkipList[T, struct{}]
+}
+
+// NewSkipListSet creates an empty SkipListSet ordered by compare.
+func NewSkipListSet[T set.Setable](compare func(a, b T) int) *SkipListSet[T] {
+	return &SkipListSet[T]{list: skiplist.New[T, struct{}](compare)}
+}
+
+// NewSeededSkipListSet creates an empty SkipListSet whose node levels are
+// drawn with the given probability from a generator seeded with seed.
+func NewSeededSkipListSet[T set.Setable](compare func(a, b T) int, probability float64, seed int64) *SkipListSet[T] {
+	return &SkipListSet[T]{list: skiplist.NewWithProbability[T, struct{}](compare, probability, seed)}
+}
+
+// Add inserts one or more elements into the SkipListSet.
+func (s *SkipListSet[T]) Add(values ...T) {
+	for _, value := range values {
+		s.list.Put(value, struct{}{})
+	}
+}
+
+// Remove deletes one or more elements from the SkipListSet.
+func (s *SkipListSet[T]) Remove(values ...T) {
+	s.list.Delete(values...)
+}
+
+// Contains checks if all specified elements are in the SkipListSet.
+func (s *SkipListSet[T]) Contains(values ...T) bool {
+	for _, value := range values {
+		if !s.list.ContainsKey(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Size returns the number of elements in the SkipListSet.
+func (s *SkipListSet[T]) Size() int {
+	return s.list.Size()
+}
+
+// IsEmpty checks if the SkipListSet has no elements.
+func (s *SkipListSet[T]) IsEmpty() bool {
+	return s.list.IsEmpty()
+}
+
+// Clear removes all elements from the SkipListSet.
+func (s *SkipListSet[T]) Clear() {
+	s.list.Clear()
+}
+
+// ToString returns a string representation of the SkipListSet in order.
+func (s *SkipListSet[T]) ToString() string {
+	return toString("SkipListSet", s.list.Keys())
+}
+
+// ToSlice returns the elements in order.
+func (s *SkipListSet[T]) ToSlice() []T {
+	return s.list.Keys()
+}
+
+// First returns the lowest element.
+func (s *SkipListSet[T]) First() (T, bool) {
+	return s.list.FirstKey()
+}
+
+// Last returns the highest element.
+func (s *SkipListSet[T]) Last() (T, bool) {
+	return s.list.LastKey()
+}
+
+// Floor returns the greatest element less than or equal to value.
+func (s *SkipListSet[T]) Floor(value T) (T, bool) {
+	return s.list.FloorKey(value)
+}
+
+// Ceiling returns the least element greater than or equal to value.
+func (s *SkipListSet[T]) Ceiling(value T) (T, bool) {
+	return s.list.CeilingKey(value)
+}
+
+// Lower returns the greatest element strictly less than value.
+func (s *SkipListSet[T]) Lower(value T) (T, bool) {
+	return s.list.LowerKey(value)
+}
+
+// Higher returns the least element strictly greater than value.
+func (s *SkipListSet[T]) Higher(value T) (T, bool) {
+	return s.list.HigherKey(value)
+}
+
+// PollFirst removes and returns the lowest element.
+func (s *SkipListSet[T]) PollFirst() (T, bool) {
+	entry, ok := s.list.PollFirst()
+	return entry.Key, ok
+}
+
+// PollLast removes and returns the highest element.
+func (s *SkipListSet[T]) PollLast() (T, bool) {
+	entry, ok := s.list.PollLast()
+	return entry.Key, ok
+}
+
+func toString[T any](name string, values []T) string {
+	items := make([]string, 0, len(values))
+	for _, value := range values {
+		items = append(items, fmt.Sprintf("%v", value))
+	}
+	return name + " : [" + strings.Join(items, ", ") + "]"
+}
@@ -0,0 +1,177 @@
+package skiplistset_test
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set/mocks"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/set/skiplistset"
+	"github.com/stretchr/testify/assert"
+)
+
+func byID(a, b *mocks.MockSetable) int {
+	return strings.Compare(a.ID, b.ID)
+}
+
+func item(i int) *mocks.MockSetable {
+	return mocks.NewMockSetable(fmt.Sprintf("%05d", i))
+}
+
+var sets = []struct {
+	name string
+	new  func() set.SortedSet[*mocks.MockSetable]
+}{
+	{"SkipListSet", func() set.SortedSet[*mocks.MockSetable] { return skiplistset.NewSkipListSet(byID) }},
+	{"SeededSkipListSet", func() set.SortedSet[*mocks.MockSetable] {
+		return skiplistset.NewSeededSkipListSet(byID, 0.5, 1)
+	}},
+	{"ConcurrentSkipListSet", func() set.SortedSet[*mocks.MockSetable] {
+		return skiplistset.NewConcurrentSkipListSet(byID)
+	}},
+}
+
+func TestSortedSet_BasicOperations(t *testing.T) {
+	for _, tc := range sets {
+		t.Run(tc.name, func(t *testing.T) {
+			s := tc.new()
+			itemA := mocks.NewMockSetable("a")
+			itemB := mocks.NewMockSetable("b")
+			itemC := mocks.NewMockSetable("c")
+			assert.True(t, s.IsEmpty())
+
+			s.Add(itemC, itemA, itemB, mocks.NewMockSetable("a"))
+			assert.Equal(t, 3, s.Size())
+			assert.True(t, s.Contains(itemA, itemB))
+			assert.False(t, s.Contains(itemA, mocks.NewMockSetable("d")))
+			assert.Equal(t, []*mocks.MockSetable{itemA, itemB, itemC}, s.ToSlice())
+			assert.Contains(t, s.ToString(), "[&{a}, &{b}, &{c}]")
+
+			s.Remove(itemB, mocks.NewMockSetable("x"))
+			assert.Equal(t, []*mocks.MockSetable{itemA, itemC}, s.ToSlice())
+
+			s.Clear()
+			assert.True(t, s.IsEmpty())
+			assert.Equal(t, 0, s.Size())
+		})
+	}
+}
+
+func TestSortedSet_Navigation(t *testing.T) {
+	for _, tc := range sets {
+		t.Run(tc.name, func(t *testing.T) {
+			s := tc.new()
+			_, ok := s.First()
+			assert.False(t, ok)
+			for _, i := range []int{40, 10, 30, 20} {
+				s.Add(item(i))
+			}
+
+			first, _ := s.First()
+			last, _ := s.Last()
+			assert.Equal(t, item(10), first)
+			assert.Equal(t, item(40), last)
+
+			value, _ := s.Floor(item(25))
+			assert.Equal(t, item(20), value)
+			value, _ = s.Floor(item(20))
+			assert.Equal(t, item(20), value)
+			value, _ = s.Lower(item(20))
+			assert.Equal(t, item(10), value)
+			_, ok = s.Lower(item(10))
+			assert.False(t, ok)
+			value, _ = s.Ceiling(item(25))
+			assert.Equal(t, item(30), value)
+			value, _ = s.Higher(item(30))
+			assert.Equal(t, item(40), value)
+			_, ok = s.Higher(item(40))
+			assert.False(t, ok)
+
+			value, _ = s.PollFirst()
+			assert.Equal(t, item(10), value)
+			value, _ = s.PollLast()
+			assert.Equal(t, item(40), value)
+			assert.Equal(t, []*mocks.MockSetable{item(20), item(30)}, s.ToSlice())
+		})
+	}
+}
+
+func TestConcurrentSkipListSet_ConcurrentAccess(t *testing.T) {
+	s := skiplistset.NewConcurrentSkipListSet(byID)
+	const workers, perWorker = 8, 500
+
+	// Every worker adds its own range, plus a shared range that all of
+	// them add and remove again
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				s.Add(item(w*perWorker + i))
+				shared := item(workers*perWorker + i%50)
+				s.Add(shared)
+				s.Remove(shared)
+				if i%2 == 1 {
+					s.Remove(item(w*perWorker + i))
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	var want []*mocks.MockSetable
+	for i := 0; i < workers*perWorker; i += 2 {
+		want = append(want, item(i))
+	}
+	assert.Equal(t, want, s.ToSlice())
+	assert.Equal(t, len(want), s.Size())
+	for _, value := range want {
+		assert.True(t, s.Contains(value))
+	}
+}
+
+func TestConcurrentSkipListSet_ConcurrentPoll(t *testing.T) {
+	s := skiplistset.NewConcurrentSkipListSet(byID)
+	const total = 2000
+	for i := 0; i < total; i++ {
+		s.Add(item(i))
+	}
+
+	// Every element is polled by exactly one goroutine
+	seen := make([]map[string]bool, 4)
+	var wg sync.WaitGroup
+	for w := range seen {
+		seen[w] = map[string]bool{}
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for {
+				var value *mocks.MockSetable
+				var ok bool
+				if w%2 == 0 {
+					value, ok = s.PollFirst()
+				} else {
+					value, ok = s.PollLast()
+				}
+				if !ok {
+					return
+				}
+				seen[w][value.ID] = true
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	all := map[string]bool{}
+	for _, m := range seen {
+		for id := range m {
+			assert.False(t, all[id], id)
+			all[id] = true
+		}
+	}
+	assert.Len(t, all, total)
+	assert.True(t, s.IsEmpty())
+}
@@ -0,0 +1,31 @@
+package set
+
+// SortedSet is a Set that keeps its elements ordered by a comparator.
+// The navigation methods return false when no element qualifies.
+type SortedSet[T Setable] interface {
+	Set[T]
+
+	// First returns the lowest element in the set.
+	First() (T, bool)
+
+	// Last returns the highest element in the set.
+	Last() (T, bool)
+
+	// Floor returns the greatest element less than or equal to value.
+	Floor(value T) (T, bool)
+
+	// Ceiling returns the least element greater than or equal to value.
+	Ceiling(value T) (T, bool)
+
+	// Lower returns the greatest element strictly less than value.
+	Lower(value T) (T, bool)
+
+	// Higher returns the least element strictly greater than value.
+	Higher(value T) (T, bool)
+
+	// PollFirst removes and returns the lowest element in the set.
+	PollFirst() (T, bool)
+
+	// PollLast removes and returns the highest element in the set.
+	PollLast() (T, bool)
+}
@@ -0,0 +1,192 @@
+package avl_test
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/avl"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTree_BasicOperations(t *testing.T) {
+	tree := avl.NewOrdered[int, string]()
+	assert.True(t, tree.IsEmpty())
+	assert.Nil(t, tree.First())
+
+	assert.True(t, tree.Put(20, "b"))
+	assert.True(t, tree.Put(10, "a"))
+	assert.True(t, tree.Put(30, "c"))
+	assert.False(t, tree.Put(20, "B"))
+	assert.Equal(t, 3, tree.Len())
+
+	value, ok := tree.Get(20)
+	assert.True(t, ok)
+	assert.Equal(t, "B", value)
+	assert.True(t, tree.Contains(10))
+	assert.False(t, tree.Contains(15))
+	assert.Equal(t, []int{10, 20, 30}, tree.Keys())
+
+	assert.True(t, tree.Delete(20))
+	assert.False(t, tree.Delete(20))
+	assert.Equal(t, []int{10, 30}, tree.Keys())
+	assert.NoError(t, tree.Validate())
+
+	tree.Clear()
+	assert.Equal(t, 0, tree.Len())
+	assert.NoError(t, tree.Validate())
+}
+
+func TestTree_NavigationAndHandles(t *testing.T) {
+	// Keys in descending order exercise navigation through the comparator
+	tree := avl.New[int, string](func(a, b int) int { return b - a })
+	for _, key := range []int{5, 1, 9, 3, 7} {
+		tree.Put(key, "")
+	}
+	assert.Equal(t, []int{9, 7, 5, 3, 1}, tree.Keys())
+	assert.Equal(t, 5, tree.Floor(5).Key())
+	assert.Equal(t, 7, tree.Floor(6).Key())
+	assert.Equal(t, 3, tree.Ceiling(4).Key())
+	assert.Equal(t, 7, tree.Lower(5).Key())
+	assert.Equal(t, 3, tree.Higher(5).Key())
+	assert.Nil(t, tree.Floor(10))
+	assert.Nil(t, tree.Higher(1))
+
+	var keys []int
+	for n := tree.Last(); n != nil; n = n.Prev() {
+		keys = append(keys, n.Key())
+	}
+	assert.Equal(t, []int{1, 3, 5, 7, 9}, keys)
+
+	// Handles stay valid while rotations move their nodes around
+	handle := tree.Find(7)
+	for key := 10; key < 40; key++ {
+		tree.Put(key, "")
+	}
+	handle.SetValue("seven")
+	value, _ := tree.Get(7)
+	assert.Equal(t, "seven", value)
+	tree.Remove(handle)
+	assert.False(t, tree.Contains(7))
+	assert.NoError(t, tree.Validate())
+
+	it := tree.Iterator()
+	assert.Equal(t, 39, it.Next().Key())
+	for it.HasNext() {
+		it.Next()
+	}
+	assert.Panics(t, func() { it.Next() })
+}
+
+func TestTree_InsertRotations(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		keys []int
+	}{
+		{"left-left", []int{30, 20, 10}},
+		{"right-right", []int{10, 20, 30}},
+		{"left-right", []int{30, 10, 20}},
+		{"right-left", []int{10, 30, 20}},
+	} {
+		tree := avl.NewOrdered[int, int]()
+		for _, key := range tc.keys {
+			tree.Put(key, key)
+		}
+		assert.Equal(t, []int{20, 10, 30}, tree.Preorder(), tc.name)
+		assert.Equal(t, 2, tree.Height(), tc.name)
+		assert.NoError(t, tree.Validate())
+	}
+}
+
+func TestTree_DeleteRotations(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		keys []int
+		want []int
+	}{
+		{"single", []int{20, 10, 30, 40}, []int{30, 20, 40}},
+		{"double", []int{20, 10, 30, 25}, []int{25, 20, 30}},
+		// A balanced sibling takes a single rotation and keeps the height
+		{"balanced sibling", []int{20, 10, 30, 25, 35}, []int{30, 20, 25, 35}},
+	} {
+		tree := avl.NewOrdered[int, int]()
+		for _, key := range tc.keys {
+			tree.Put(key, key)
+		}
+		tree.Delete(10)
+		assert.Equal(t, tc.want, tree.Preorder(), tc.name)
+		assert.NoError(t, tree.Validate())
+	}
+
+	// Removing from the short side of a Fibonacci tree rotates at every
+	// level on the way up
+	tree := avl.NewOrdered[int, int]()
+	for _, key := range []int{8, 5, 11, 3, 7, 10, 12, 2, 4, 6, 9, 1} {
+		tree.Put(key, key)
+	}
+	assert.Equal(t, 5, tree.Height())
+	tree.Delete(12)
+	assert.Equal(t, []int{5, 3, 2, 1, 4, 8, 7, 6, 10, 9, 11}, tree.Preorder())
+	assert.Equal(t, 4, tree.Height())
+	assert.NoError(t, tree.Validate())
+}
+
+func TestTree_RandomOperationsKeepInvariants(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	tree := avl.NewOrdered[int, int]()
+	reference := map[int]int{}
+
+	for i := 0; i < 5000; i++ {
+		key := rng.Intn(500)
+		if rng.Intn(3) == 0 {
+			// Removing through a handle rebalances from the unlinked node
+			if n := tree.Find(key); n != nil {
+				assert.Equal(t, reference[key], n.Value())
+				tree.Remove(n)
+			}
+			delete(reference, key)
+		} else {
+			_, exists := reference[key]
+			assert.Equal(t, !exists, tree.Put(key, i))
+			reference[key] = i
+		}
+		if i%100 == 0 {
+			if err := tree.Validate(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	assert.NoError(t, tree.Validate())
+	// An AVL tree is at most about 1.44 times as deep as a perfect one
+	assert.Less(t, float64(tree.Height()), 1.4405*math.Log2(float64(tree.Len()+2)))
+
+	keys := make([]int, 0, len(reference))
+	for key := range reference {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	assert.Equal(t, keys, tree.Keys())
+	tree.Each(func(key, value int) bool {
+		assert.Equal(t, reference[key], value)
+		return true
+	})
+}
+
+func TestTree_SequentialInsertStaysBalanced(t *testing.T) {
+	// Ascending keys fill a perfect tree whenever 2^k-1 of them are in
+	tree := avl.NewOrdered[int, int]()
+	for i := 1; i < 1<<10; i++ {
+		tree.Put(i, i)
+	}
+	assert.Equal(t, 10, tree.Height())
+	assert.Equal(t, 1<<9, tree.Preorder()[0])
+	assert.NoError(t, tree.Validate())
+
+	for i := 1; i < 1<<10; i += 2 {
+		tree.Delete(i)
+	}
+	assert.NoError(t, tree.Validate())
+	assert.Equal(t, 1<<9-1, tree.Len())
+	assert.Equal(t, 9, tree.Height())
+}
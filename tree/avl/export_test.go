@@ -0,0 +1,23 @@
+package avl
+
+// Preorder returns the keys in preorder, which exposes the shape of the
+// tree to the tests.
+func (t *Tree[K, V]) Preorder() []K {
+	var keys []K
+	var walk func(n *Node[K, V])
+	walk = func(n *Node[K, V]) {
+		if n != nil {
+			keys = append(keys, n.key)
+			walk(n.left)
+			walk(n.right)
+		}
+	}
+	walk(t.root)
+	return keys
+}
+
+// Height returns the height stored at the root, which Validate checks
+// against the shape of the tree.
+func (t *Tree[K, V]) Height() int {
+	return heightOf(t.root)
+}
@@ -0,0 +1,628 @@
+package bplustree
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps"
+)
+
+// ErrNotSorted is returned by FromSorted when the keys are not strictly
+// ascending.
+var ErrNotSorted = errors.New("bplustree: keys are not strictly ascending")
+
+// owner marks the nodes a tree may modify in place. Clones get a new
+// owner, so nodes shared between trees are copied before being changed.
+type owner struct {
+	_ byte
+}
+
+// node is a leaf holding entries or an internal node holding separator
+// keys. Every key in children[i] is below keys[i] and every key in
+// children[i+1] is at or above it. Leaves are chained in key order.
+type node[K any, V any] struct {
+	keys     []K
+	values   []V
+	children []*node[K, V]
+	next     *node[K, V]
+	owner    *owner
+}
+
+func (n *node[K, V]) leaf() bool {
+	return len(n.children) == 0
+}
+
+// Tree is an in-memory B+ tree. Entries live only in the leaves, which are
+// linked so that range scans walk contiguous slices without going back up
+// the tree. Inner nodes hold only keys, so more of them fit in cache.
+//
+// Clone is O(1): both trees share their nodes and copy the nodes on a
+// modified path lazily. A leaf copied that way is still the target of its
+// predecessor's link, which may be shared with the other tree, so the tree
+// records the copy in links and scans follow it from there. The record is
+// dropped once the predecessor is itself rewritten.
+type Tree[K any, V any] struct {
+	root    *node[K, V]
+	degree  int
+	size    int
+	compare func(a, b K) int
+	owner   *owner
+	// links maps leaves replaced by a copy to that copy
+	links       map[*node[K, V]]*node[K, V]
+	sharedLinks bool
+}
+
+// New creates an empty Tree of the given minimum degree, so that nodes
+// hold at most 2·degree-1 keys. It panics if degree is less than 2.
+func New[K any, V any](degree int, compare func(a, b K) int) *Tree[K, V] {
+	if degree < 2 {
+		panic(fmt.Sprintf("bplustree: invalid degree %d", degree))
+	}
+	return &Tree[K, V]{degree: degree, compare: compare, owner: &owner{}}
+}
+
+// NewOrdered creates an empty Tree using the natural order of K.
+func NewOrdered[K cmp.Ordered, V any](degree int) *Tree[K, V] {
+	return New[K, V](degree, cmp.Compare[K])
+}
+
+// FromSorted builds a Tree from entries sorted by strictly ascending key
+// in O(n), filling the leaves and then each level above them.
+func FromSorted[K any, V any](degree int, compare func(a, b K) int, entries []maps.Entry[K, V]) (*Tree[K, V], error) {
+	t := New[K, V](degree, compare)
+	for i := 1; i < len(entries); i++ {
+		if compare(entries[i-1].Key, entries[i].Key) >= 0 {
+			return nil, ErrNotSorted
+		}
+	}
+	if len(entries) == 0 {
+		return t, nil
+	}
+	size := len(entries)
+
+	// Level holds the nodes of the level being built with their lowest keys
+	var level []*node[K, V]
+	var lows []K
+	var prev *node[K, V]
+	for _, part := range partition(len(entries), t.maxKeys()) {
+		leaf := &node[K, V]{owner: t.owner}
+		for _, e := range entries[:part] {
+			leaf.keys = append(leaf.keys, e.Key)
+			leaf.values = append(leaf.values, e.Value)
+		}
+		if prev != nil {
+			prev.next = leaf
+		}
+		prev = leaf
+		level = append(level, leaf)
+		lows = append(lows, entries[0].Key)
+		entries = entries[part:]
+	}
+	for len(level) > 1 {
+		var parents []*node[K, V]
+		var parentLows []K
+		for _, part := range partition(len(level), t.maxKeys()+1) {
+			parent := &node[K, V]{
+				keys:     append([]K(nil), lows[1:part]...),
+				children: append([]*node[K, V](nil), level[:part]...),
+				owner:    t.owner,
+			}
+			parents = append(parents, parent)
+			parentLows = append(parentLows, lows[0])
+			level, lows = level[part:], lows[part:]
+		}
+		level, lows = parents, parentLows
+	}
+	t.root = level[0]
+	t.size = size
+	return t, nil
+}
+
+// partition splits n items into the fewest groups of at most size items
+// and returns group sizes that differ by at most one.
+func partition(n, size int) []int {
+	groups := (n + size - 1) / size
+	parts := make([]int, groups)
+	for i := range parts {
+		parts[i] = n / groups
+		if i < n%groups {
+			parts[i]++
+		}
+	}
+	return parts
+}
+
+// Clone returns a snapshot of the tree in O(1). The two trees share their
+// nodes until either is modified, at which point only the nodes on the
+// modified path are copied.
+func (t *Tree[K, V]) Clone() *Tree[K, V] {
+	clone := *t
+	t.owner = &owner{}
+	clone.owner = &owner{}
+	if t.links != nil {
+		t.sharedLinks, clone.sharedLinks = true, true
+	}
+	return &clone
+}
+
+// Degree returns the minimum degree of the tree.
+func (t *Tree[K, V]) Degree() int {
+	return t.degree
+}
+
+// Len returns the number of entries in the tree.
+func (t *Tree[K, V]) Len() int {
+	return t.size
+}
+
+// IsEmpty checks if the tree has no entries.
+func (t *Tree[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Clear removes every entry. Clones are not affected.
+func (t *Tree[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+	t.links, t.sharedLinks = nil, false
+}
+
+// Get returns the value for key and whether it was found.
+func (t *Tree[K, V]) Get(key K) (V, bool) {
+	if t.root != nil {
+		leaf := t.findLeaf(key)
+		if i, found := t.search(leaf, key); found {
+			return leaf.values[i], true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains checks if key is in the tree.
+func (t *Tree[K, V]) Contains(key K) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Put inserts key with value, or replaces the value if key is present.
+// It reports whether key was inserted.
+func (t *Tree[K, V]) Put(key K, value V) bool {
+	if t.root == nil {
+		t.root = &node[K, V]{keys: []K{key}, values: []V{value}, owner: t.owner}
+		t.size++
+		return true
+	}
+	t.root = t.mutable(t.root)
+	inserted, separator, right := t.insert(t.root, key, value)
+	if right != nil {
+		t.root = &node[K, V]{keys: []K{separator}, children: []*node[K, V]{t.root, right}, owner: t.owner}
+	}
+	if inserted {
+		t.size++
+	}
+	return inserted
+}
+
+// Delete removes key and reports whether it was present.
+func (t *Tree[K, V]) Delete(key K) bool {
+	// Check first so that a miss copies no shared nodes
+	if !t.Contains(key) {
+		return false
+	}
+	t.root = t.mutable(t.root)
+	t.remove(t.root, key)
+	t.size--
+	switch {
+	case t.root.leaf() && len(t.root.keys) == 0:
+		t.Clear()
+	case !t.root.leaf() && len(t.root.keys) == 0:
+		t.root = t.root.children[0]
+	}
+	return true
+}
+
+// Min returns the entry with the lowest key.
+func (t *Tree[K, V]) Min() (maps.Entry[K, V], bool) {
+	if t.root == nil {
+		return maps.Entry[K, V]{}, false
+	}
+	n := t.firstLeaf()
+	return maps.Entry[K, V]{Key: n.keys[0], Value: n.values[0]}, true
+}
+
+// Max returns the entry with the highest key.
+func (t *Tree[K, V]) Max() (maps.Entry[K, V], bool) {
+	if t.root == nil {
+		return maps.Entry[K, V]{}, false
+	}
+	n := t.root
+	for !n.leaf() {
+		n = n.children[len(n.children)-1]
+	}
+	last := len(n.keys) - 1
+	return maps.Entry[K, V]{Key: n.keys[last], Value: n.values[last]}, true
+}
+
+// Each calls fn for every entry in key order until fn returns false.
+// fn must not modify the tree.
+func (t *Tree[K, V]) Each(fn func(key K, value V) bool) {
+	if t.root == nil {
+		return
+	}
+	for n := t.firstLeaf(); n != nil; n = t.nextLeaf(n) {
+		for i, key := range n.keys {
+			if !fn(key, n.values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Range calls fn in key order for every entry with lo <= key < hi until
+// fn returns false. It descends once to the leaf holding lo and then
+// follows the leaf links. fn must not modify the tree.
+func (t *Tree[K, V]) Range(lo, hi K, fn func(key K, value V) bool) {
+	if t.root == nil {
+		return
+	}
+	n := t.findLeaf(lo)
+	i, _ := t.search(n, lo)
+	for ; n != nil; n, i = t.nextLeaf(n), 0 {
+		for ; i < len(n.keys); i++ {
+			if t.compare(n.keys[i], hi) >= 0 || !fn(n.keys[i], n.values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns the keys in order.
+func (t *Tree[K, V]) Keys() []K {
+	keys := make([]K, 0, t.size)
+	t.Each(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Validate checks the key order, the node fill bounds, that every leaf is
+// at the same depth, the leaf chain and the size. It is meant for tests.
+func (t *Tree[K, V]) Validate() error {
+	if t.root == nil {
+		if t.size != 0 {
+			return fmt.Errorf("bplustree: empty tree has size %d", t.size)
+		}
+		return nil
+	}
+	var leaves []*node[K, V]
+	if _, err := t.validate(t.root, true, nil, nil, &leaves); err != nil {
+		return err
+	}
+	count := 0
+	for i, leaf := range leaves {
+		var next *node[K, V]
+		if i+1 < len(leaves) {
+			next = leaves[i+1]
+		}
+		if t.nextLeaf(leaf) != next {
+			return errors.New("bplustree: broken leaf chain")
+		}
+		count += len(leaf.keys)
+	}
+	if count != t.size {
+		return fmt.Errorf("bplustree: size is %d but tree holds %d keys", t.size, count)
+	}
+	return nil
+}
+
+// validate checks the subtree at n, whose keys must lie in [lo, hi) when
+// the bounds are set, collects its leaves and returns its height.
+func (t *Tree[K, V]) validate(n *node[K, V], root bool, lo, hi *K, leaves *[]*node[K, V]) (int, error) {
+	if len(n.keys) > t.maxKeys() || (!root && len(n.keys) < t.degree-1) || (!root && len(n.keys) == 0) {
+		return 0, fmt.Errorf("bplustree: node holds %d keys", len(n.keys))
+	}
+	for i, key := range n.keys {
+		if (i > 0 && t.compare(n.keys[i-1], key) >= 0) ||
+			(lo != nil && t.compare(key, *lo) < 0) || (hi != nil && t.compare(key, *hi) >= 0) {
+			return 0, fmt.Errorf("bplustree: key %v out of order", key)
+		}
+	}
+	if n.leaf() {
+		if len(n.values) != len(n.keys) {
+			return 0, errors.New("bplustree: keys and values differ in length")
+		}
+		*leaves = append(*leaves, n)
+		return 1, nil
+	}
+	if len(n.children) != len(n.keys)+1 {
+		return 0, fmt.Errorf("bplustree: node with %d keys has %d children", len(n.keys), len(n.children))
+	}
+	height := 0
+	for i, child := range n.children {
+		childLo, childHi := lo, hi
+		if i > 0 {
+			childLo = &n.keys[i-1]
+		}
+		if i < len(n.keys) {
+			childHi = &n.keys[i]
+		}
+		h, err := t.validate(child, false, childLo, childHi, leaves)
+		if err != nil {
+			return 0, err
+		}
+		if i > 0 && h != height {
+			return 0, errors.New("bplustree: leaves at different depths")
+		}
+		height = h
+	}
+	return height + 1, nil
+}
+
+func (t *Tree[K, V]) maxKeys() int {
+	return 2*t.degree - 1
+}
+
+func (t *Tree[K, V]) firstLeaf() *node[K, V] {
+	n := t.root
+	for n != nil && !n.leaf() {
+		n = n.children[0]
+	}
+	return n
+}
+
+// search returns the index of the first key not less than key and
+// whether it equals key.
+func (t *Tree[K, V]) search(n *node[K, V], key K) (int, bool) {
+	i := sort.Search(len(n.keys), func(i int) bool { return t.compare(n.keys[i], key) >= 0 })
+	return i, i < len(n.keys) && t.compare(n.keys[i], key) == 0
+}
+
+// childIndex returns the child of the internal node n that covers key.
+func (t *Tree[K, V]) childIndex(n *node[K, V], key K) int {
+	return sort.Search(len(n.keys), func(i int) bool { return t.compare(n.keys[i], key) > 0 })
+}
+
+// nextLeaf returns the leaf after n, following the copies recorded in
+// links when n still points at a leaf that has been replaced.
+func (t *Tree[K, V]) nextLeaf(n *node[K, V]) *node[K, V] {
+	next := n.next
+	for next != nil {
+		c, ok := t.links[next]
+		if !ok {
+			break
+		}
+		next = c
+	}
+	return next
+}
+
+// setNext links the mutable leaf n to next. The copies its old link went
+// through are forgotten, as n was the only leaf of the tree pointing there.
+func (t *Tree[K, V]) setNext(n, next *node[K, V]) {
+	t.dropLinks(n.next)
+	n.next = next
+}
+
+// dropLinks forgets the copies recorded for old and for the leaves it was
+// replaced by in turn.
+func (t *Tree[K, V]) dropLinks(old *node[K, V]) {
+	for old != nil {
+		c, ok := t.links[old]
+		if !ok {
+			break
+		}
+		t.ownLinks()
+		delete(t.links, old)
+		old = c
+	}
+}
+
+// ownLinks gives the tree its own copy of links after a Clone.
+func (t *Tree[K, V]) ownLinks() {
+	if t.sharedLinks {
+		links := make(map[*node[K, V]]*node[K, V], len(t.links))
+		for old, c := range t.links {
+			links[old] = c
+		}
+		t.links, t.sharedLinks = links, false
+	}
+}
+
+// mutable returns n if the tree owns it, or a copy owned by the tree.
+func (t *Tree[K, V]) mutable(n *node[K, V]) *node[K, V] {
+	if n.owner == t.owner {
+		return n
+	}
+	c := &node[K, V]{
+		keys:  append(make([]K, 0, t.maxKeys()+1), n.keys...),
+		owner: t.owner,
+	}
+	if !n.leaf() {
+		c.children = append(make([]*node[K, V], 0, t.maxKeys()+2), n.children...)
+		return c
+	}
+	c.values = append(make([]V, 0, t.maxKeys()+1), n.values...)
+	c.next = n.next
+	t.setNext(c, t.nextLeaf(n))
+	// The predecessor of n may be shared, so its link is redirected here
+	t.ownLinks()
+	if t.links == nil {
+		t.links = make(map[*node[K, V]]*node[K, V])
+	}
+	t.links[n] = c
+	return c
+}
+
+// child returns the i-th child of n, made mutable and stored back in n.
+func (t *Tree[K, V]) child(n *node[K, V], i int) *node[K, V] {
+	c := t.mutable(n.children[i])
+	n.children[i] = c
+	return c
+}
+
+// findLeaf returns the leaf that holds key if it is present.
+func (t *Tree[K, V]) findLeaf(key K) *node[K, V] {
+	n := t.root
+	for !n.leaf() {
+		n = n.children[t.childIndex(n, key)]
+	}
+	return n
+}
+
+// insert adds key below the mutable node n. When n overflows it is split,
+// and the new right sibling is returned together with the lowest key it
+// covers.
+func (t *Tree[K, V]) insert(n *node[K, V], key K, value V) (bool, K, *node[K, V]) {
+	var zero K
+	if n.leaf() {
+		i, found := t.search(n, key)
+		if found {
+			n.values[i] = value
+			return false, zero, nil
+		}
+		n.keys = insertAt(n.keys, i, key)
+		n.values = insertAt(n.values, i, value)
+		if len(n.keys) <= t.maxKeys() {
+			return true, zero, nil
+		}
+		mid := len(n.keys) / 2
+		right := &node[K, V]{
+			keys:   append([]K(nil), n.keys[mid:]...),
+			values: append([]V(nil), n.values[mid:]...),
+			next:   t.nextLeaf(n),
+			owner:  t.owner,
+		}
+		n.keys = truncate(n.keys, mid)
+		n.values = truncate(n.values, mid)
+		t.setNext(n, right)
+		return true, right.keys[0], right
+	}
+
+	i := t.childIndex(n, key)
+	inserted, separator, child := t.insert(t.child(n, i), key, value)
+	if child == nil {
+		return inserted, zero, nil
+	}
+	n.keys = insertAt(n.keys, i, separator)
+	n.children = insertAt(n.children, i+1, child)
+	if len(n.keys) <= t.maxKeys() {
+		return inserted, zero, nil
+	}
+	mid := len(n.keys) / 2
+	separator = n.keys[mid]
+	right := &node[K, V]{
+		keys:     append([]K(nil), n.keys[mid+1:]...),
+		children: append([]*node[K, V](nil), n.children[mid+1:]...),
+		owner:    t.owner,
+	}
+	n.keys = truncate(n.keys, mid)
+	n.children = truncate(n.children, mid+1)
+	return inserted, separator, right
+}
+
+// remove deletes key below the mutable node n and refills any child left too small.
+// Separator keys of removed entries may stay behind as routing keys.
+func (t *Tree[K, V]) remove(n *node[K, V], key K) bool {
+	if n.leaf() {
+		i, found := t.search(n, key)
+		if found {
+			n.keys = removeAt(n.keys, i)
+			n.values = removeAt(n.values, i)
+		}
+		return found
+	}
+	i := t.childIndex(n, key)
+	if !t.remove(t.child(n, i), key) {
+		return false
+	}
+	if len(n.children[i].keys) < t.degree-1 {
+		t.fill(n, i)
+	}
+	return true
+}
+
+// fill refills the mutable child i of n by borrowing from a sibling or
+// merging with one.
+func (t *Tree[K, V]) fill(n *node[K, V], i int) {
+	c := n.children[i]
+	switch {
+	case i > 0 && len(n.children[i-1].keys) >= t.degree:
+		left := t.child(n, i-1)
+		last := len(left.keys) - 1
+		if c.leaf() {
+			c.keys = insertAt(c.keys, 0, left.keys[last])
+			c.values = insertAt(c.values, 0, left.values[last])
+			left.values = removeAt(left.values, last)
+			n.keys[i-1] = c.keys[0]
+		} else {
+			c.keys = insertAt(c.keys, 0, n.keys[i-1])
+			c.children = insertAt(c.children, 0, left.children[last+1])
+			left.children = removeAt(left.children, last+1)
+			n.keys[i-1] = left.keys[last]
+		}
+		left.keys = removeAt(left.keys, last)
+	case i < len(n.keys) && len(n.children[i+1].keys) >= t.degree:
+		right := t.child(n, i+1)
+		if c.leaf() {
+			c.keys = append(c.keys, right.keys[0])
+			c.values = append(c.values, right.values[0])
+			right.values = removeAt(right.values, 0)
+			n.keys[i] = right.keys[1]
+		} else {
+			c.keys = append(c.keys, n.keys[i])
+			c.children = append(c.children, right.children[0])
+			right.children = removeAt(right.children, 0)
+			n.keys[i] = right.keys[0]
+		}
+		right.keys = removeAt(right.keys, 0)
+	case i < len(n.keys):
+		t.merge(n, i)
+	default:
+		t.merge(n, i-1)
+	}
+}
+
+// merge joins child i+1 of n into child i and drops the separator between
+// them. The right child is only read, so it may still be shared.
+func (t *Tree[K, V]) merge(n *node[K, V], i int) {
+	left, right := t.child(n, i), n.children[i+1]
+	if left.leaf() {
+		left.keys = append(left.keys, right.keys...)
+		left.values = append(left.values, right.values...)
+		next := t.nextLeaf(right)
+		// right leaves the tree, so nothing follows its link any more
+		t.dropLinks(right.next)
+		t.setNext(left, next)
+	} else {
+		left.keys = append(append(left.keys, n.keys[i]), right.keys...)
+		left.children = append(left.children, right.children...)
+	}
+	n.keys = removeAt(n.keys, i)
+	n.children = removeAt(n.children, i+1)
+}
+
+func insertAt[T any](s []T, i int, value T) []T {
+	var zero T
+	s = append(s, zero)
+	copy(s[i+1:], s[i:])
+	s[i] = value
+	return s
+}
+
+func removeAt[T any](s []T, i int) []T {
+	var zero T
+	copy(s[i:], s[i+1:])
+	s[len(s)-1] = zero
+	return s[:len(s)-1]
+}
+
+// truncate shortens s to n elements, clearing the rest so that the
+// dropped values can be collected.
+func truncate[T any](s []T, n int) []T {
+	clear(s[n:])
+	return s[:n]
+}
@@ -0,0 +1,269 @@
+package bplustree_test
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/bplustree"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTree_BasicOperations(t *testing.T) {
+	tree := bplustree.NewOrdered[int, string](2)
+	assert.True(t, tree.IsEmpty())
+	_, ok := tree.Min()
+	assert.False(t, ok)
+
+	assert.True(t, tree.Put(20, "b"))
+	assert.True(t, tree.Put(10, "a"))
+	assert.True(t, tree.Put(30, "c"))
+	assert.True(t, tree.Put(40, "d"))
+	assert.False(t, tree.Put(20, "B"))
+	assert.Equal(t, 4, tree.Len())
+
+	value, ok := tree.Get(20)
+	assert.True(t, ok)
+	assert.Equal(t, "B", value)
+	assert.False(t, tree.Contains(15))
+	assert.Equal(t, []int{10, 20, 30, 40}, tree.Keys())
+	first, _ := tree.Min()
+	last, _ := tree.Max()
+	assert.Equal(t, maps.Entry[int, string]{Key: 10, Value: "a"}, first)
+	assert.Equal(t, maps.Entry[int, string]{Key: 40, Value: "d"}, last)
+
+	assert.True(t, tree.Delete(20))
+	assert.False(t, tree.Delete(20))
+	assert.Equal(t, []int{10, 30, 40}, tree.Keys())
+	assert.NoError(t, tree.Validate())
+
+	tree.Clear()
+	assert.Equal(t, 0, tree.Len())
+	assert.NoError(t, tree.Validate())
+	assert.Panics(t, func() { bplustree.NewOrdered[int, int](1) })
+}
+
+func TestTree_Range(t *testing.T) {
+	tree := bplustree.NewOrdered[int, int](3)
+	for i := 0; i < 100; i++ {
+		tree.Put(i*2, i)
+	}
+
+	var keys []int
+	tree.Range(11, 21, func(key, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	assert.Equal(t, []int{12, 14, 16, 18, 20}, keys)
+
+	keys = keys[:0]
+	tree.Range(50, 1000, func(key, _ int) bool {
+		keys = append(keys, key)
+		return len(keys) < 3
+	})
+	assert.Equal(t, []int{50, 52, 54}, keys)
+
+	keys = keys[:0]
+	tree.Range(7, 7, func(key, _ int) bool {
+		keys = append(keys, key)
+		return true
+	})
+	assert.Empty(t, keys)
+}
+
+func TestTree_FromSorted(t *testing.T) {
+	for _, degree := range []int{2, 3, 8} {
+		for _, n := range []int{0, 1, 2, 3, 7, 8, 50, 1000, 4321} {
+			entries := make([]maps.Entry[int, int], n)
+			for i := range entries {
+				entries[i] = maps.Entry[int, int]{Key: i, Value: -i}
+			}
+			tree, err := bplustree.FromSorted(degree, cmp.Compare[int], entries)
+			assert.NoError(t, err)
+			if err := tree.Validate(); err != nil {
+				t.Fatalf("degree %d, %d entries: %v", degree, n, err)
+			}
+			assert.Equal(t, n, tree.Len())
+			value, ok := tree.Get(n - 1)
+			assert.Equal(t, n > 0, ok)
+			if ok {
+				assert.Equal(t, 1-n, value)
+			}
+
+			// The packed tree stays valid under further updates
+			tree.Put(n, 0)
+			tree.Delete(0)
+			assert.NoError(t, tree.Validate())
+		}
+	}
+
+	_, err := bplustree.FromSorted(2, cmp.Compare[int], []maps.Entry[int, int]{{Key: 2}, {Key: 1}})
+	assert.ErrorIs(t, err, bplustree.ErrNotSorted)
+}
+
+func TestTree_CloneIsIndependent(t *testing.T) {
+	tree := bplustree.NewOrdered[int, int](2)
+	for i := 0; i < 1000; i++ {
+		tree.Put(i, i)
+	}
+	snapshot := tree.Clone()
+
+	for i := 0; i < 1000; i += 2 {
+		tree.Delete(i)
+	}
+	tree.Put(5, 500)
+	snapshot.Put(2000, 2000)
+
+	assert.Equal(t, 500, tree.Len())
+	assert.Equal(t, 1001, snapshot.Len())
+	value, _ := tree.Get(5)
+	assert.Equal(t, 500, value)
+	value, _ = snapshot.Get(5)
+	assert.Equal(t, 5, value)
+	assert.True(t, snapshot.Contains(0))
+	assert.False(t, tree.Contains(2000))
+	assert.NoError(t, tree.Validate())
+	assert.NoError(t, snapshot.Validate())
+}
+
+func TestTree_CloneIsCheap(t *testing.T) {
+	tree := bplustree.NewOrdered[int, int](16)
+	for i := 0; i < 100000; i++ {
+		tree.Put(i, i)
+	}
+
+	// Cloning allocates the new tree header and owners, never nodes
+	allocs := testing.AllocsPerRun(100, func() { tree.Clone() })
+	assert.LessOrEqual(t, allocs, 3.0)
+
+	// A write to a clone copies one root-to-leaf path, not the tree
+	allocs = testing.AllocsPerRun(100, func() {
+		tree.Clone().Put(50000, -1)
+	})
+	assert.Less(t, allocs, 20.0)
+	value, _ := tree.Get(50000)
+	assert.Equal(t, 50000, value)
+}
+
+func TestTree_RangeFollowsLeafLinks(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	tree := bplustree.NewOrdered[int, int](2)
+	reference := map[int]int{}
+	for _, key := range rng.Perm(300) {
+		tree.Put(2*key, key)
+		reference[2*key] = key
+	}
+	trees := []*bplustree.Tree[int, int]{tree}
+	references := []map[int]int{reference}
+
+	// Splits on one tree and merges on its clones rewrite leaves shared
+	// with the others, so every scan crosses copied leaves
+	for round := 0; round < 4; round++ {
+		trees = append(trees, trees[round].Clone())
+		copied := map[int]int{}
+		for key, value := range references[round] {
+			copied[key] = value
+		}
+		references = append(references, copied)
+		for i, tree := range trees {
+			for j := 0; j < 100; j++ {
+				key := rng.Intn(600)
+				if (i+round)%2 == 0 {
+					tree.Put(key, -key)
+					references[i][key] = -key
+				} else {
+					tree.Delete(key)
+					delete(references[i], key)
+				}
+			}
+		}
+
+		for i, tree := range trees {
+			if err := tree.Validate(); err != nil {
+				t.Fatal(err)
+			}
+			keys := make([]int, 0, len(references[i]))
+			for key := range references[i] {
+				keys = append(keys, key)
+			}
+			slices.Sort(keys)
+			assert.Equal(t, keys, tree.Keys())
+
+			lo := rng.Intn(300)
+			hi := lo + rng.Intn(300)
+			var got []int
+			tree.Range(lo, hi, func(key, value int) bool {
+				assert.Equal(t, references[i][key], value)
+				got = append(got, key)
+				return true
+			})
+			from, _ := slices.BinarySearch(keys, lo)
+			to, _ := slices.BinarySearch(keys, hi)
+			assert.Equal(t, keys[from:to], got, "tree %d range [%d, %d)", i, lo, hi)
+		}
+	}
+}
+
+func TestTree_RandomOperationsKeepInvariants(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, degree := range []int{2, 3, 5} {
+		tree := bplustree.NewOrdered[int, int](degree)
+		reference := map[int]int{}
+		var snapshot *bplustree.Tree[int, int]
+		var snapshotKeys []int
+
+		for i := 0; i < 5000; i++ {
+			key := rng.Intn(500)
+			if rng.Intn(3) == 0 {
+				_, exists := reference[key]
+				assert.Equal(t, exists, tree.Delete(key))
+				delete(reference, key)
+			} else {
+				_, exists := reference[key]
+				assert.Equal(t, !exists, tree.Put(key, i))
+				reference[key] = i
+			}
+			if i%100 == 0 {
+				if err := tree.Validate(); err != nil {
+					t.Fatal(err)
+				}
+				// Older snapshots must not see later changes
+				if snapshot != nil {
+					assert.Equal(t, snapshotKeys, snapshot.Keys())
+				}
+				snapshot = tree.Clone()
+				snapshotKeys = tree.Keys()
+			}
+		}
+		assert.NoError(t, tree.Validate())
+
+		keys := make([]int, 0, len(reference))
+		for key := range reference {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		assert.Equal(t, keys, tree.Keys())
+		tree.Each(func(key, value int) bool {
+			assert.Equal(t, reference[key], value)
+			return true
+		})
+
+		// Range bounds may fall on separators whose entries were deleted
+		for lo := 0; lo < 500; lo += 37 {
+			var got []int
+			tree.Range(lo, lo+50, func(key, _ int) bool {
+				got = append(got, key)
+				return true
+			})
+			var want []int
+			for _, key := range keys {
+				if key >= lo && key < lo+50 {
+					want = append(want, key)
+				}
+			}
+			assert.Equal(t, want, got)
+		}
+	}
+}
@@ -0,0 +1,549 @@
+package btree
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/maps"
+)
+
+// ErrNotSorted is returned by FromSorted when the keys are not strictly
+// ascending.
+var ErrNotSorted = errors.New("btree: keys are not strictly ascending")
+
+// owner marks the nodes a tree may modify in place. Clones get a new
+// owner, so nodes shared between trees are copied before being changed.
+type owner struct {
+	_ byte
+}
+
+// node holds between degree-1 and 2·degree-1 sorted keys, except the
+// root which may hold fewer. An internal node has one child more than
+// it has keys.
+type node[K any, V any] struct {
+	keys     []K
+	values   []V
+	children []*node[K, V]
+	owner    *owner
+}
+
+func (n *node[K, V]) leaf() bool {
+	return len(n.children) == 0
+}
+
+// Tree is an in-memory B-tree. Each node stores many keys in contiguous
+// slices, which makes it far more cache friendly than a binary tree for
+// large indexes. Clone is O(1): both trees share their nodes and copy
+// them lazily on write.
+type Tree[K any, V any] struct {
+	root    *node[K, V]
+	degree  int
+	size    int
+	compare func(a, b K) int
+	owner   *owner
+}
+
+// New creates an empty Tree of the given minimum degree, so that nodes
+// hold at most 2·degree-1 keys. It panics if degree is less than 2.
+func New[K any, V any](degree int, compare func(a, b K) int) *Tree[K, V] {
+	if degree < 2 {
+		panic(fmt.Sprintf("btree: invalid degree %d", degree))
+	}
+	return &Tree[K, V]{degree: degree, compare: compare, owner: &owner{}}
+}
+
+// NewOrdered creates an empty Tree using the natural order of K.
+func NewOrdered[K cmp.Ordered, V any](degree int) *Tree[K, V] {
+	return New[K, V](degree, cmp.Compare[K])
+}
+
+// FromSorted builds a Tree from entries sorted by strictly ascending key
+// in O(n), packing the nodes instead of inserting one entry at a time.
+func FromSorted[K any, V any](degree int, compare func(a, b K) int, entries []maps.Entry[K, V]) (*Tree[K, V], error) {
+	t := New[K, V](degree, compare)
+	for i := 1; i < len(entries); i++ {
+		if compare(entries[i-1].Key, entries[i].Key) >= 0 {
+			return nil, ErrNotSorted
+		}
+	}
+	if len(entries) == 0 {
+		return t, nil
+	}
+	height, capacity := 1, 2*degree-1
+	for capacity < len(entries) {
+		height++
+		capacity = capacity*2*degree + 2*degree - 1
+	}
+	t.root = t.build(entries, height, 2)
+	t.size = len(entries)
+	return t, nil
+}
+
+// build packs entries into a subtree of the given height whose root has
+// at least minChildren children when it is internal.
+func (t *Tree[K, V]) build(entries []maps.Entry[K, V], height, minChildren int) *node[K, V] {
+	n := &node[K, V]{owner: t.owner}
+	if height == 1 {
+		for _, e := range entries {
+			n.keys = append(n.keys, e.Key)
+			n.values = append(n.values, e.Value)
+		}
+		return n
+	}
+	// A child subtree holds at most childCapacity-1 entries, and one more
+	// separator is needed between each pair of children
+	childCapacity := 1
+	for i := 1; i < height; i++ {
+		childCapacity *= 2 * t.degree
+	}
+	children := max(minChildren, (len(entries)+childCapacity)/childCapacity)
+	items := len(entries) - (children - 1)
+	start := 0
+	for c := 0; c < children; c++ {
+		count := items / children
+		if c < items%children {
+			count++
+		}
+		n.children = append(n.children, t.build(entries[start:start+count], height-1, t.degree))
+		start += count
+		if c < children-1 {
+			n.keys = append(n.keys, entries[start].Key)
+			n.values = append(n.values, entries[start].Value)
+			start++
+		}
+	}
+	return n
+}
+
+// Clone returns a snapshot of the tree in O(1). The two trees share their
+// nodes until either is modified, at which point only the nodes on the
+// modified path are copied.
+func (t *Tree[K, V]) Clone() *Tree[K, V] {
+	clone := *t
+	t.owner = &owner{}
+	clone.owner = &owner{}
+	return &clone
+}
+
+// Degree returns the minimum degree of the tree.
+func (t *Tree[K, V]) Degree() int {
+	return t.degree
+}
+
+// Len returns the number of entries in the tree.
+func (t *Tree[K, V]) Len() int {
+	return t.size
+}
+
+// IsEmpty checks if the tree has no entries.
+func (t *Tree[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Clear removes every entry. Clones are not affected.
+func (t *Tree[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// Get returns the value for key and whether it was found.
+func (t *Tree[K, V]) Get(key K) (V, bool) {
+	for n := t.root; n != nil; {
+		i, found := t.search(n, key)
+		if found {
+			return n.values[i], true
+		}
+		if n.leaf() {
+			break
+		}
+		n = n.children[i]
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains checks if key is in the tree.
+func (t *Tree[K, V]) Contains(key K) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Put inserts key with value, or replaces the value if key is present.
+// It reports whether key was inserted.
+func (t *Tree[K, V]) Put(key K, value V) bool {
+	if t.root == nil {
+		t.root = &node[K, V]{keys: []K{key}, values: []V{value}, owner: t.owner}
+		t.size++
+		return true
+	}
+	t.root = t.mutable(t.root)
+	if len(t.root.keys) == t.maxKeys() {
+		root := &node[K, V]{children: []*node[K, V]{t.root}, owner: t.owner}
+		t.split(root, 0)
+		t.root = root
+	}
+	if t.insert(t.root, key, value) {
+		t.size++
+		return true
+	}
+	return false
+}
+
+// Delete removes key and reports whether it was present.
+func (t *Tree[K, V]) Delete(key K) bool {
+	if t.root == nil {
+		return false
+	}
+	t.root = t.mutable(t.root)
+	removed := t.remove(t.root, key)
+	if len(t.root.keys) == 0 {
+		if t.root.leaf() {
+			t.root = nil
+		} else {
+			t.root = t.root.children[0]
+		}
+	}
+	if removed {
+		t.size--
+	}
+	return removed
+}
+
+// Min returns the entry with the lowest key.
+func (t *Tree[K, V]) Min() (maps.Entry[K, V], bool) {
+	if t.root == nil {
+		return maps.Entry[K, V]{}, false
+	}
+	n := t.root
+	for !n.leaf() {
+		n = n.children[0]
+	}
+	return maps.Entry[K, V]{Key: n.keys[0], Value: n.values[0]}, true
+}
+
+// Max returns the entry with the highest key.
+func (t *Tree[K, V]) Max() (maps.Entry[K, V], bool) {
+	if t.root == nil {
+		return maps.Entry[K, V]{}, false
+	}
+	n := t.root
+	for !n.leaf() {
+		n = n.children[len(n.children)-1]
+	}
+	last := len(n.keys) - 1
+	return maps.Entry[K, V]{Key: n.keys[last], Value: n.values[last]}, true
+}
+
+// Each calls fn for every entry in key order until fn returns false.
+// fn must not modify the tree.
+func (t *Tree[K, V]) Each(fn func(key K, value V) bool) {
+	if t.root != nil {
+		t.each(t.root, nil, nil, fn)
+	}
+}
+
+// Range calls fn in key order for every entry with lo <= key < hi until
+// fn returns false. fn must not modify the tree.
+func (t *Tree[K, V]) Range(lo, hi K, fn func(key K, value V) bool) {
+	if t.root != nil {
+		t.each(t.root, &lo, &hi, fn)
+	}
+}
+
+// Keys returns the keys in order.
+func (t *Tree[K, V]) Keys() []K {
+	keys := make([]K, 0, t.size)
+	t.Each(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Validate checks the key order, the node fill bounds, that every leaf is
+// at the same depth and the size. It is meant for tests.
+func (t *Tree[K, V]) Validate() error {
+	if t.root == nil {
+		if t.size != 0 {
+			return fmt.Errorf("btree: empty tree has size %d", t.size)
+		}
+		return nil
+	}
+	count := 0
+	if _, err := t.validate(t.root, true, nil, nil, &count); err != nil {
+		return err
+	}
+	if count != t.size {
+		return fmt.Errorf("btree: size is %d but tree holds %d keys", t.size, count)
+	}
+	return nil
+}
+
+// validate checks the subtree at n, whose keys must lie strictly between
+// lo and hi when they are set, and returns its height.
+func (t *Tree[K, V]) validate(n *node[K, V], root bool, lo, hi *K, count *int) (int, error) {
+	if len(n.keys) > t.maxKeys() || (!root && len(n.keys) < t.degree-1) || len(n.keys) == 0 {
+		return 0, fmt.Errorf("btree: node holds %d keys", len(n.keys))
+	}
+	if len(n.values) != len(n.keys) {
+		return 0, errors.New("btree: keys and values differ in length")
+	}
+	for i, key := range n.keys {
+		if (i > 0 && t.compare(n.keys[i-1], key) >= 0) ||
+			(lo != nil && t.compare(*lo, key) >= 0) || (hi != nil && t.compare(key, *hi) >= 0) {
+			return 0, fmt.Errorf("btree: key %v out of order", key)
+		}
+	}
+	*count += len(n.keys)
+	if n.leaf() {
+		return 1, nil
+	}
+	if len(n.children) != len(n.keys)+1 {
+		return 0, fmt.Errorf("btree: node with %d keys has %d children", len(n.keys), len(n.children))
+	}
+	height := 0
+	for i, child := range n.children {
+		childLo, childHi := lo, hi
+		if i > 0 {
+			childLo = &n.keys[i-1]
+		}
+		if i < len(n.keys) {
+			childHi = &n.keys[i]
+		}
+		h, err := t.validate(child, false, childLo, childHi, count)
+		if err != nil {
+			return 0, err
+		}
+		if i > 0 && h != height {
+			return 0, errors.New("btree: leaves at different depths")
+		}
+		height = h
+	}
+	return height + 1, nil
+}
+
+// each walks the subtree at n in order, limited to [lo, hi) when set,
+// and reports whether the walk should continue.
+func (t *Tree[K, V]) each(n *node[K, V], lo, hi *K, fn func(key K, value V) bool) bool {
+	start := 0
+	if lo != nil {
+		start, _ = t.search(n, *lo)
+	}
+	for i := start; i <= len(n.keys); i++ {
+		if !n.leaf() && !t.each(n.children[i], lo, hi, fn) {
+			return false
+		}
+		if i == len(n.keys) {
+			break
+		}
+		if hi != nil && t.compare(n.keys[i], *hi) >= 0 {
+			return false
+		}
+		if !fn(n.keys[i], n.values[i]) {
+			return false
+		}
+		// Every key after the first child visited is above lo
+		lo = nil
+	}
+	return true
+}
+
+func (t *Tree[K, V]) maxKeys() int {
+	return 2*t.degree - 1
+}
+
+// search returns the index of the first key not less than key and
+// whether it equals key.
+func (t *Tree[K, V]) search(n *node[K, V], key K) (int, bool) {
+	i := sort.Search(len(n.keys), func(i int) bool { return t.compare(n.keys[i], key) >= 0 })
+	return i, i < len(n.keys) && t.compare(n.keys[i], key) == 0
+}
+
+// mutable returns n if the tree owns it, or a copy owned by the tree.
+func (t *Tree[K, V]) mutable(n *node[K, V]) *node[K, V] {
+	if n.owner == t.owner {
+		return n
+	}
+	c := &node[K, V]{
+		keys:   append(make([]K, 0, t.maxKeys()), n.keys...),
+		values: append(make([]V, 0, t.maxKeys()), n.values...),
+		owner:  t.owner,
+	}
+	if !n.leaf() {
+		c.children = append(make([]*node[K, V], 0, t.maxKeys()+1), n.children...)
+	}
+	return c
+}
+
+// child returns the i-th child of n, made mutable and stored back in n.
+func (t *Tree[K, V]) child(n *node[K, V], i int) *node[K, V] {
+	c := t.mutable(n.children[i])
+	n.children[i] = c
+	return c
+}
+
+// split moves the upper half of the full child i of n into a new sibling
+// and lifts its median key into n.
+func (t *Tree[K, V]) split(n *node[K, V], i int) {
+	full := t.child(n, i)
+	mid := t.degree - 1
+	right := &node[K, V]{
+		keys:   append([]K(nil), full.keys[mid+1:]...),
+		values: append([]V(nil), full.values[mid+1:]...),
+		owner:  t.owner,
+	}
+	if !full.leaf() {
+		right.children = append([]*node[K, V](nil), full.children[mid+1:]...)
+		clear(full.children[mid+1:])
+		full.children = full.children[:mid+1]
+	}
+	n.keys = insertAt(n.keys, i, full.keys[mid])
+	n.values = insertAt(n.values, i, full.values[mid])
+	n.children = insertAt(n.children, i+1, right)
+	clear(full.keys[mid:])
+	clear(full.values[mid:])
+	full.keys = full.keys[:mid]
+	full.values = full.values[:mid]
+}
+
+// insert adds key below the mutable, non-full node n.
+func (t *Tree[K, V]) insert(n *node[K, V], key K, value V) bool {
+	for {
+		i, found := t.search(n, key)
+		if found {
+			n.values[i] = value
+			return false
+		}
+		if n.leaf() {
+			n.keys = insertAt(n.keys, i, key)
+			n.values = insertAt(n.values, i, value)
+			return true
+		}
+		if len(n.children[i].keys) == t.maxKeys() {
+			t.split(n, i)
+			switch c := t.compare(key, n.keys[i]); {
+			case c == 0:
+				n.values[i] = value
+				return false
+			case c > 0:
+				i++
+			}
+		}
+		n = t.child(n, i)
+	}
+}
+
+// remove deletes key below the mutable node n, which holds at least
+// degree keys unless it is the root, so that a key can always be taken
+// from it without refilling it first.
+func (t *Tree[K, V]) remove(n *node[K, V], key K) bool {
+	for {
+		i, found := t.search(n, key)
+		if n.leaf() {
+			if !found {
+				return false
+			}
+			n.keys = removeAt(n.keys, i)
+			n.values = removeAt(n.values, i)
+			return true
+		}
+		if found {
+			switch {
+			case len(n.children[i].keys) >= t.degree:
+				// Replace with the predecessor and delete that instead
+				left := t.child(n, i)
+				pred := left
+				for !pred.leaf() {
+					pred = pred.children[len(pred.children)-1]
+				}
+				last := len(pred.keys) - 1
+				n.keys[i], n.values[i] = pred.keys[last], pred.values[last]
+				return t.remove(left, pred.keys[last])
+			case len(n.children[i+1].keys) >= t.degree:
+				right := t.child(n, i+1)
+				succ := right
+				for !succ.leaf() {
+					succ = succ.children[0]
+				}
+				n.keys[i], n.values[i] = succ.keys[0], succ.values[0]
+				return t.remove(right, succ.keys[0])
+			default:
+				t.merge(n, i)
+				n = n.children[i]
+				continue
+			}
+		}
+		n = t.fill(n, i)
+	}
+}
+
+// fill makes sure child i of n holds at least degree keys, borrowing from
+// a sibling or merging with one, and returns the child to descend into.
+func (t *Tree[K, V]) fill(n *node[K, V], i int) *node[K, V] {
+	c := t.child(n, i)
+	if len(c.keys) >= t.degree {
+		return c
+	}
+	switch {
+	case i > 0 && len(n.children[i-1].keys) >= t.degree:
+		left := t.child(n, i-1)
+		last := len(left.keys) - 1
+		c.keys = insertAt(c.keys, 0, n.keys[i-1])
+		c.values = insertAt(c.values, 0, n.values[i-1])
+		n.keys[i-1], n.values[i-1] = left.keys[last], left.values[last]
+		left.keys = removeAt(left.keys, last)
+		left.values = removeAt(left.values, last)
+		if !left.leaf() {
+			c.children = insertAt(c.children, 0, left.children[last+1])
+			left.children = removeAt(left.children, last+1)
+		}
+		return c
+	case i < len(n.keys) && len(n.children[i+1].keys) >= t.degree:
+		right := t.child(n, i+1)
+		c.keys = append(c.keys, n.keys[i])
+		c.values = append(c.values, n.values[i])
+		n.keys[i], n.values[i] = right.keys[0], right.values[0]
+		right.keys = removeAt(right.keys, 0)
+		right.values = removeAt(right.values, 0)
+		if !right.leaf() {
+			c.children = append(c.children, right.children[0])
+			right.children = removeAt(right.children, 0)
+		}
+		return c
+	case i < len(n.keys):
+		t.merge(n, i)
+		return n.children[i]
+	default:
+		t.merge(n, i-1)
+		return n.children[i-1]
+	}
+}
+
+// merge joins child i, key i and child i+1 of n into child i.
+func (t *Tree[K, V]) merge(n *node[K, V], i int) {
+	left := t.child(n, i)
+	right := n.children[i+1]
+	left.keys = append(append(left.keys, n.keys[i]), right.keys...)
+	left.values = append(append(left.values, n.values[i]), right.values...)
+	left.children = append(left.children, right.children...)
+	n.keys = removeAt(n.keys, i)
+	n.values = removeAt(n.values, i)
+	n.children = removeAt(n.children, i+1)
+}
+
+func insertAt[T any](s []T, i int, value T) []T {
+	var zero T
+	s = append(s, zero)
+	copy(s[i+1:], s[i:])
+	s[i] = value
+	return s
+}
+
+func removeAt[T any](s []T, i int) []T {
+	var zero T
+	copy(s[i:], s[i+1:])
+	s[len(s)-1] = zero
+	return s[:len(s)-1]
+}
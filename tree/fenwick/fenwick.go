@@ -0,0 +1,125 @@
+package fenwick
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/monoid"
+)
+
+// Tree is a Fenwick tree, or binary indexed tree, over values of an
+// abelian group. It adds to single values and answers prefix and range
+// aggregates in O(log n) using a single array of n values.
+type Tree[T any] struct {
+	tree  []T
+	group monoid.Group[T]
+}
+
+// New creates a Tree of n values, all equal to the identity of group.
+// It panics if n is negative.
+func New[T any](n int, group monoid.Group[T]) *Tree[T] {
+	if n < 0 {
+		panic(fmt.Sprintf("fenwick: invalid size %d", n))
+	}
+	tree := make([]T, n+1)
+	for i := range tree {
+		tree[i] = group.Identity
+	}
+	return &Tree[T]{tree: tree, group: group}
+}
+
+// NewFrom creates a Tree holding values, built in O(n).
+func NewFrom[T any](values []T, group monoid.Group[T]) *Tree[T] {
+	t := &Tree[T]{tree: make([]T, len(values)+1), group: group}
+	t.tree[0] = group.Identity
+	copy(t.tree[1:], values)
+	for i := 1; i < len(t.tree); i++ {
+		if j := i + i&-i; j < len(t.tree) {
+			t.tree[j] = group.Combine(t.tree[j], t.tree[i])
+		}
+	}
+	return t
+}
+
+// Len returns the number of values.
+func (t *Tree[T]) Len() int {
+	return len(t.tree) - 1
+}
+
+// Add combines delta into the value at index i. It panics if i is out of
+// range.
+func (t *Tree[T]) Add(i int, delta T) {
+	t.checkIndex(i)
+	for i++; i < len(t.tree); i += i & -i {
+		t.tree[i] = t.group.Combine(t.tree[i], delta)
+	}
+}
+
+// Set replaces the value at index i. It panics if i is out of range.
+func (t *Tree[T]) Set(i int, value T) {
+	t.Add(i, t.group.Combine(value, t.group.Inverse(t.Get(i))))
+}
+
+// Get returns the value at index i. It panics if i is out of range.
+func (t *Tree[T]) Get(i int) T {
+	t.checkIndex(i)
+	return t.Query(i, i+1)
+}
+
+// Prefix returns the aggregate of the values in [0, to). It panics if to
+// is out of range.
+func (t *Tree[T]) Prefix(to int) T {
+	if to < 0 || to > t.Len() {
+		panic(fmt.Sprintf("fenwick: index %d out of range with size %d", to, t.Len()))
+	}
+	result := t.group.Identity
+	for ; to > 0; to -= to & -to {
+		result = t.group.Combine(result, t.tree[to])
+	}
+	return result
+}
+
+// Query returns the aggregate of the values in [from, to). It panics if
+// the range is invalid.
+func (t *Tree[T]) Query(from, to int) T {
+	if from < 0 || to > t.Len() || from > to {
+		panic(fmt.Sprintf("fenwick: invalid range [%d, %d) with size %d", from, to, t.Len()))
+	}
+	return t.group.Combine(t.Prefix(to), t.group.Inverse(t.Prefix(from)))
+}
+
+// LowerBound returns the smallest index i such that the aggregate of
+// [0, i] is not less than target under compare, or Len if there is none.
+// Prefix aggregates must be non-decreasing, as with sums of non-negative
+// values. It runs in O(log n) by walking down the implicit tree.
+func (t *Tree[T]) LowerBound(target T, compare func(a, b T) int) int {
+	if t.Len() == 0 {
+		return 0
+	}
+	pos := 0
+	acc := t.group.Identity
+	for step := 1 << (bits.Len(uint(t.Len())) - 1); step > 0; step >>= 1 {
+		if pos+step <= t.Len() {
+			if next := t.group.Combine(acc, t.tree[pos+step]); compare(next, target) < 0 {
+				pos += step
+				acc = next
+			}
+		}
+	}
+	return pos
+}
+
+// Values returns the values in order.
+func (t *Tree[T]) Values() []T {
+	values := make([]T, t.Len())
+	for i := range values {
+		values[i] = t.Get(i)
+	}
+	return values
+}
+
+func (t *Tree[T]) checkIndex(i int) {
+	if i < 0 || i >= t.Len() {
+		panic(fmt.Sprintf("fenwick: index %d out of range with size %d", i, t.Len()))
+	}
+}
@@ -0,0 +1,80 @@
+package fenwick
+
+import (
+	"fmt"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/monoid"
+)
+
+// Tree2D is a two dimensional Fenwick tree over a grid of values. It adds
+// to single cells and answers rectangle aggregates in O(log rows ·
+// log cols).
+type Tree2D[T any] struct {
+	rows, cols int
+	tree       []T
+	group      monoid.Group[T]
+}
+
+// New2D creates a Tree2D of rows by cols values, all equal to the identity
+// of group. It panics if either size is negative.
+func New2D[T any](rows, cols int, group monoid.Group[T]) *Tree2D[T] {
+	if rows < 0 || cols < 0 {
+		panic(fmt.Sprintf("fenwick: invalid size %dx%d", rows, cols))
+	}
+	tree := make([]T, (rows+1)*(cols+1))
+	for i := range tree {
+		tree[i] = group.Identity
+	}
+	return &Tree2D[T]{rows: rows, cols: cols, tree: tree, group: group}
+}
+
+// Rows returns the number of rows.
+func (t *Tree2D[T]) Rows() int {
+	return t.rows
+}
+
+// Cols returns the number of columns.
+func (t *Tree2D[T]) Cols() int {
+	return t.cols
+}
+
+// Add combines delta into the value at row r and column c. It panics if
+// the cell is out of range.
+func (t *Tree2D[T]) Add(r, c int, delta T) {
+	if r < 0 || r >= t.rows || c < 0 || c >= t.cols {
+		panic(fmt.Sprintf("fenwick: cell (%d, %d) out of range with size %dx%d", r, c, t.rows, t.cols))
+	}
+	for i := r + 1; i <= t.rows; i += i & -i {
+		for j := c + 1; j <= t.cols; j += j & -j {
+			k := i*(t.cols+1) + j
+			t.tree[k] = t.group.Combine(t.tree[k], delta)
+		}
+	}
+}
+
+// Prefix returns the aggregate of the rectangle of rows [0, r) and
+// columns [0, c). It panics if r or c is out of range.
+func (t *Tree2D[T]) Prefix(r, c int) T {
+	if r < 0 || r > t.rows || c < 0 || c > t.cols {
+		panic(fmt.Sprintf("fenwick: cell (%d, %d) out of range with size %dx%d", r, c, t.rows, t.cols))
+	}
+	result := t.group.Identity
+	for i := r; i > 0; i -= i & -i {
+		for j := c; j > 0; j -= j & -j {
+			result = t.group.Combine(result, t.tree[i*(t.cols+1)+j])
+		}
+	}
+	return result
+}
+
+// Query returns the aggregate of the rectangle of rows [r1, r2) and
+// columns [c1, c2). It panics if the rectangle is invalid.
+func (t *Tree2D[T]) Query(r1, c1, r2, c2 int) T {
+	if r1 > r2 || c1 > c2 {
+		panic(fmt.Sprintf("fenwick: invalid rectangle [%d, %d)x[%d, %d)", r1, r2, c1, c2))
+	}
+	g := t.group
+	outer := g.Combine(t.Prefix(r2, c2), t.Prefix(r1, c1))
+	inner := g.Combine(t.Prefix(r1, c2), t.Prefix(r2, c1))
+	return g.Combine(outer, g.Inverse(inner))
+}
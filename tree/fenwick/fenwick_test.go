@@ -0,0 +1,159 @@
+package fenwick_test
+
+import (
+	"cmp"
+	"math/rand"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/fenwick"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/monoid"
+	"github.com/stretchr/testify/assert"
+)
+
+// xor is a group without Scale, so range trees fall back to Times.
+var xor = monoid.Group[uint]{
+	Monoid:  monoid.Monoid[uint]{Combine: func(a, b uint) uint { return a ^ b }},
+	Inverse: func(a uint) uint { return a },
+}
+
+func TestTree_BasicOperations(t *testing.T) {
+	tree := fenwick.NewFrom([]int{5, 3, 8, 1, 9, 2}, monoid.Additive[int]())
+	assert.Equal(t, 6, tree.Len())
+	assert.Equal(t, 28, tree.Prefix(6))
+	assert.Equal(t, 16, tree.Prefix(3))
+	assert.Equal(t, 12, tree.Query(1, 4))
+	assert.Equal(t, 0, tree.Query(2, 2))
+
+	tree.Add(2, 10)
+	tree.Set(0, 1)
+	assert.Equal(t, 18, tree.Get(2))
+	assert.Equal(t, []int{1, 3, 18, 1, 9, 2}, tree.Values())
+
+	assert.Panics(t, func() { tree.Add(6, 1) })
+	assert.Panics(t, func() { tree.Query(3, 2) })
+	assert.Panics(t, func() { fenwick.New(-1, monoid.Additive[int]()) })
+}
+
+func TestTree_LowerBound(t *testing.T) {
+	tree := fenwick.NewFrom([]int{2, 0, 3, 1, 4}, monoid.Additive[int]())
+	assert.Equal(t, 0, tree.LowerBound(0, cmp.Compare[int]))
+	assert.Equal(t, 0, tree.LowerBound(2, cmp.Compare[int]))
+	assert.Equal(t, 2, tree.LowerBound(3, cmp.Compare[int]))
+	assert.Equal(t, 2, tree.LowerBound(5, cmp.Compare[int]))
+	assert.Equal(t, 3, tree.LowerBound(6, cmp.Compare[int]))
+	assert.Equal(t, 4, tree.LowerBound(10, cmp.Compare[int]))
+	assert.Equal(t, 5, tree.LowerBound(11, cmp.Compare[int]))
+	assert.Equal(t, 0, fenwick.New(0, monoid.Additive[int]()).LowerBound(1, cmp.Compare[int]))
+}
+
+func TestTree_MatchesSlice(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	const n = 100
+	tree := fenwick.New(n, monoid.Additive[int]())
+	model := make([]int, n)
+
+	for i := 0; i < 2000; i++ {
+		from := rng.Intn(n + 1)
+		to := from + rng.Intn(n-from+1)
+		if rng.Intn(2) == 0 && from < n {
+			delta := rng.Intn(10)
+			tree.Add(from, delta)
+			model[from] += delta
+			continue
+		}
+		sum := 0
+		for _, value := range model[from:to] {
+			sum += value
+		}
+		assert.Equal(t, sum, tree.Query(from, to))
+
+		// Prefix sums never decrease, so LowerBound finds the first index
+		// reaching any target
+		target := rng.Intn(sum + 2)
+		want, prefix := 0, 0
+		for want < n && prefix+model[want] < target {
+			prefix += model[want]
+			want++
+		}
+		assert.Equal(t, want, tree.LowerBound(target, cmp.Compare[int]))
+	}
+}
+
+func TestDiffTree_RangeUpdatePointQuery(t *testing.T) {
+	tree := fenwick.NewDiffTree(5, monoid.Additive[int]())
+	tree.Update(1, 4, 3)
+	tree.Update(0, 2, 1)
+	tree.Update(3, 5, -2)
+
+	values := make([]int, tree.Len())
+	for i := range values {
+		values[i] = tree.Get(i)
+	}
+	assert.Equal(t, []int{1, 4, 3, 1, -2}, values)
+	assert.Panics(t, func() { tree.Get(5) })
+}
+
+func TestRangeTree_MatchesSlice(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	const n = 64
+	sums := fenwick.NewRangeTree(n, monoid.Additive[int]())
+	xors := fenwick.NewRangeTree(n, xor)
+	model := make([]int, n)
+	xorModel := make([]uint, n)
+
+	for i := 0; i < 2000; i++ {
+		from := rng.Intn(n + 1)
+		to := from + rng.Intn(n-from+1)
+		if rng.Intn(2) == 0 {
+			delta := rng.Intn(21) - 10
+			sums.Update(from, to, delta)
+			xors.Update(from, to, uint(delta+10))
+			for j := from; j < to; j++ {
+				model[j] += delta
+				xorModel[j] ^= uint(delta + 10)
+			}
+			continue
+		}
+		sum, x := 0, uint(0)
+		for j := from; j < to; j++ {
+			sum += model[j]
+			x ^= xorModel[j]
+		}
+		assert.Equal(t, sum, sums.Query(from, to))
+		assert.Equal(t, x, xors.Query(from, to))
+		if from < n {
+			assert.Equal(t, model[from], sums.Get(from))
+		}
+	}
+}
+
+func TestTree2D_MatchesGrid(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	const rows, cols = 13, 17
+	tree := fenwick.New2D(rows, cols, monoid.Additive[int]())
+	var grid [rows][cols]int
+	assert.Equal(t, rows, tree.Rows())
+	assert.Equal(t, cols, tree.Cols())
+
+	for i := 0; i < 2000; i++ {
+		if rng.Intn(2) == 0 {
+			r, c, delta := rng.Intn(rows), rng.Intn(cols), rng.Intn(21)-10
+			tree.Add(r, c, delta)
+			grid[r][c] += delta
+			continue
+		}
+		r1 := rng.Intn(rows + 1)
+		r2 := r1 + rng.Intn(rows-r1+1)
+		c1 := rng.Intn(cols + 1)
+		c2 := c1 + rng.Intn(cols-c1+1)
+		sum := 0
+		for r := r1; r < r2; r++ {
+			for c := c1; c < c2; c++ {
+				sum += grid[r][c]
+			}
+		}
+		assert.Equal(t, sum, tree.Query(r1, c1, r2, c2))
+	}
+	assert.Panics(t, func() { tree.Add(rows, 0, 1) })
+	assert.Panics(t, func() { tree.Query(2, 0, 1, 0) })
+}
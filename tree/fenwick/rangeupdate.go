@@ -0,0 +1,112 @@
+package fenwick
+
+import (
+	"fmt"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/monoid"
+)
+
+// DiffTree is a Fenwick tree over the differences between neighbouring
+// values. It adds to whole ranges and reads single values in O(log n).
+type DiffTree[T any] struct {
+	diffs *Tree[T]
+}
+
+// NewDiffTree creates a DiffTree of n values, all equal to the identity of
+// group. It panics if n is negative.
+func NewDiffTree[T any](n int, group monoid.Group[T]) *DiffTree[T] {
+	if n < 0 {
+		panic(fmt.Sprintf("fenwick: invalid size %d", n))
+	}
+	return &DiffTree[T]{diffs: New(n+1, group)}
+}
+
+// Len returns the number of values.
+func (t *DiffTree[T]) Len() int {
+	return t.diffs.Len() - 1
+}
+
+// Update combines delta into every value in [from, to). It panics if the
+// range is invalid.
+func (t *DiffTree[T]) Update(from, to int, delta T) {
+	if from < 0 || to > t.Len() || from > to {
+		panic(fmt.Sprintf("fenwick: invalid range [%d, %d) with size %d", from, to, t.Len()))
+	}
+	t.diffs.Add(from, delta)
+	t.diffs.Add(to, t.diffs.group.Inverse(delta))
+}
+
+// Get returns the value at index i. It panics if i is out of range.
+func (t *DiffTree[T]) Get(i int) T {
+	if i < 0 || i >= t.Len() {
+		panic(fmt.Sprintf("fenwick: index %d out of range with size %d", i, t.Len()))
+	}
+	return t.diffs.Prefix(i + 1)
+}
+
+// RangeTree is a Fenwick tree that both adds to whole ranges and answers
+// range aggregates in O(log n). It keeps two trees of differences, one of
+// them weighted by index, and needs the group to scale values by an index
+// with Times.
+type RangeTree[T any] struct {
+	diffs    *Tree[T]
+	weighted *Tree[T]
+	group    monoid.Group[T]
+}
+
+// NewRangeTree creates a RangeTree of n values, all equal to the identity
+// of group. It panics if n is negative.
+func NewRangeTree[T any](n int, group monoid.Group[T]) *RangeTree[T] {
+	if n < 0 {
+		panic(fmt.Sprintf("fenwick: invalid size %d", n))
+	}
+	return &RangeTree[T]{diffs: New(n+1, group), weighted: New(n+1, group), group: group}
+}
+
+// Len returns the number of values.
+func (t *RangeTree[T]) Len() int {
+	return t.diffs.Len() - 1
+}
+
+// Update combines delta into every value in [from, to). It panics if the
+// range is invalid.
+func (t *RangeTree[T]) Update(from, to int, delta T) {
+	t.checkRange(from, to)
+	inverse := t.group.Inverse(delta)
+	t.diffs.Add(from, delta)
+	t.diffs.Add(to, inverse)
+	t.weighted.Add(from, t.group.Times(delta, from))
+	t.weighted.Add(to, t.group.Times(inverse, to))
+}
+
+// Prefix returns the aggregate of the values in [0, to). It panics if to
+// is out of range.
+func (t *RangeTree[T]) Prefix(to int) T {
+	if to < 0 || to > t.Len() {
+		panic(fmt.Sprintf("fenwick: index %d out of range with size %d", to, t.Len()))
+	}
+	// Each value before to is the sum of the differences up to it, so the
+	// prefix counts the difference at i (to-i) times
+	return t.group.Combine(t.group.Times(t.diffs.Prefix(to), to), t.group.Inverse(t.weighted.Prefix(to)))
+}
+
+// Query returns the aggregate of the values in [from, to). It panics if
+// the range is invalid.
+func (t *RangeTree[T]) Query(from, to int) T {
+	t.checkRange(from, to)
+	return t.group.Combine(t.Prefix(to), t.group.Inverse(t.Prefix(from)))
+}
+
+// Get returns the value at index i. It panics if i is out of range.
+func (t *RangeTree[T]) Get(i int) T {
+	if i < 0 || i >= t.Len() {
+		panic(fmt.Sprintf("fenwick: index %d out of range with size %d", i, t.Len()))
+	}
+	return t.diffs.Prefix(i + 1)
+}
+
+func (t *RangeTree[T]) checkRange(from, to int) {
+	if from < 0 || to > t.Len() || from > to {
+		panic(fmt.Sprintf("fenwick: invalid range [%d, %d) with size %d", from, to, t.Len()))
+	}
+}
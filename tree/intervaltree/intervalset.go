@@ -0,0 +1,226 @@
+package intervaltree
+
+import (
+	"cmp"
+	"strings"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/rbtree"
+)
+
+// IntervalSet is a set of points stored as disjoint half-open ranges. Added
+// ranges that overlap or touch are merged, and removed ranges split the
+// ranges they cut, so the set always holds the fewest ranges covering its
+// points. The ranges are kept in a red-black tree keyed by start.
+type IntervalSet[T any] struct {
+	ranges  *rbtree.Tree[T, T]
+	compare func(a, b T) int
+}
+
+// NewIntervalSet creates an empty IntervalSet ordered by compare.
+func NewIntervalSet[T any](compare func(a, b T) int) *IntervalSet[T] {
+	return &IntervalSet[T]{ranges: rbtree.New[T, T](compare), compare: compare}
+}
+
+// NewOrderedIntervalSet creates an empty IntervalSet using the natural
+// order of T.
+func NewOrderedIntervalSet[T cmp.Ordered]() *IntervalSet[T] {
+	return NewIntervalSet[T](cmp.Compare[T])
+}
+
+// Add inserts one or more ranges, merging them with any ranges they
+// overlap or touch. Empty ranges are ignored.
+func (s *IntervalSet[T]) Add(intervals ...Interval[T]) {
+	for _, interval := range intervals {
+		if s.compare(interval.Start, interval.End) >= 0 {
+			continue
+		}
+		start, end := interval.Start, interval.End
+		if n := s.ranges.Floor(start); n != nil && s.compare(n.Value(), start) >= 0 {
+			start = n.Key()
+			end = s.maxOf(end, n.Value())
+			s.ranges.Remove(n)
+		}
+		for n := s.ranges.Ceiling(start); n != nil && s.compare(n.Key(), end) <= 0; n = s.ranges.Ceiling(start) {
+			end = s.maxOf(end, n.Value())
+			s.ranges.Remove(n)
+		}
+		s.ranges.Put(start, end)
+	}
+}
+
+// Remove deletes one or more ranges of points, trimming or splitting the
+// ranges they cut. Empty ranges are ignored.
+func (s *IntervalSet[T]) Remove(intervals ...Interval[T]) {
+	for _, interval := range intervals {
+		if s.compare(interval.Start, interval.End) >= 0 {
+			continue
+		}
+		start, end := interval.Start, interval.End
+		if n := s.ranges.Lower(start); n != nil && s.compare(n.Value(), start) > 0 {
+			rest := n.Value()
+			n.SetValue(start)
+			if s.compare(rest, end) > 0 {
+				s.ranges.Put(end, rest)
+			}
+		}
+		for n := s.ranges.Ceiling(start); n != nil && s.compare(n.Key(), end) < 0; n = s.ranges.Ceiling(start) {
+			rest := n.Value()
+			s.ranges.Remove(n)
+			if s.compare(rest, end) > 0 {
+				s.ranges.Put(end, rest)
+			}
+		}
+	}
+}
+
+// Contains checks if all specified points are in the IntervalSet.
+func (s *IntervalSet[T]) Contains(points ...T) bool {
+	for _, point := range points {
+		n := s.ranges.Floor(point)
+		if n == nil || s.compare(n.Value(), point) <= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Covers checks if every point of interval is in the IntervalSet. An
+// empty interval is always covered.
+func (s *IntervalSet[T]) Covers(interval Interval[T]) bool {
+	if s.compare(interval.Start, interval.End) >= 0 {
+		return true
+	}
+	n := s.ranges.Floor(interval.Start)
+	return n != nil && s.compare(n.Value(), interval.End) >= 0
+}
+
+// Overlaps checks if any point of interval is in the IntervalSet.
+func (s *IntervalSet[T]) Overlaps(interval Interval[T]) bool {
+	if s.compare(interval.Start, interval.End) >= 0 {
+		return false
+	}
+	if n := s.ranges.Floor(interval.Start); n != nil && s.compare(n.Value(), interval.Start) > 0 {
+		return true
+	}
+	n := s.ranges.Higher(interval.Start)
+	return n != nil && s.compare(n.Key(), interval.End) < 0
+}
+
+// Size returns the number of disjoint ranges in the IntervalSet.
+func (s *IntervalSet[T]) Size() int {
+	return s.ranges.Len()
+}
+
+// IsEmpty checks if the IntervalSet has no points.
+func (s *IntervalSet[T]) IsEmpty() bool {
+	return s.ranges.IsEmpty()
+}
+
+// Clear removes all ranges from the IntervalSet.
+func (s *IntervalSet[T]) Clear() {
+	s.ranges.Clear()
+}
+
+// ToString returns a string representation of the IntervalSet in order.
+func (s *IntervalSet[T]) ToString() string {
+	var sb strings.Builder
+	sb.WriteString("IntervalSet : [")
+	for i, interval := range s.ToSlice() {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(interval.String())
+	}
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// ToSlice returns the disjoint ranges in order.
+func (s *IntervalSet[T]) ToSlice() []Interval[T] {
+	slice := make([]Interval[T], 0, s.ranges.Len())
+	s.ranges.Each(func(start, end T) bool {
+		slice = append(slice, Interval[T]{Start: start, End: end})
+		return true
+	})
+	return slice
+}
+
+// Union returns a new IntervalSet with the points in either set.
+func (s *IntervalSet[T]) Union(other *IntervalSet[T]) *IntervalSet[T] {
+	return s.merge(other, func(inS, inOther bool) bool { return inS || inOther })
+}
+
+// Intersection returns a new IntervalSet with the points in both sets.
+func (s *IntervalSet[T]) Intersection(other *IntervalSet[T]) *IntervalSet[T] {
+	return s.merge(other, func(inS, inOther bool) bool { return inS && inOther })
+}
+
+// Difference returns a new IntervalSet with the points of s that are not
+// in other.
+func (s *IntervalSet[T]) Difference(other *IntervalSet[T]) *IntervalSet[T] {
+	return s.merge(other, func(inS, inOther bool) bool { return inS && !inOther })
+}
+
+// merge sweeps the endpoints of both sets in order and keeps the stretches
+// where keep holds, in O(n + m) plus the cost of building the result.
+func (s *IntervalSet[T]) merge(other *IntervalSet[T], keep func(inS, inOther bool) bool) *IntervalSet[T] {
+	a, b := s.ToSlice(), other.ToSlice()
+	result := NewIntervalSet[T](s.compare)
+	// Each side is a sorted list of boundaries where membership flips
+	var i, j int
+	inA, inB := false, false
+	var start T
+	open := false
+	boundary := func(ranges []Interval[T], k int, in bool) T {
+		if in {
+			return ranges[k].End
+		}
+		return ranges[k].Start
+	}
+	for i < len(a) || j < len(b) {
+		var point T
+		switch {
+		case j == len(b):
+			point = boundary(a, i, inA)
+		case i == len(a):
+			point = boundary(b, j, inB)
+		default:
+			point = s.minOf(boundary(a, i, inA), boundary(b, j, inB))
+		}
+		if i < len(a) && s.compare(boundary(a, i, inA), point) == 0 {
+			if inA {
+				i++
+			}
+			inA = !inA
+		}
+		if j < len(b) && s.compare(boundary(b, j, inB), point) == 0 {
+			if inB {
+				j++
+			}
+			inB = !inB
+		}
+		if now := keep(inA, inB); now != open {
+			if now {
+				start = point
+			} else if s.compare(start, point) < 0 {
+				result.ranges.Put(start, point)
+			}
+			open = now
+		}
+	}
+	return result
+}
+
+func (s *IntervalSet[T]) maxOf(a, b T) T {
+	if s.compare(a, b) >= 0 {
+		return a
+	}
+	return b
+}
+
+func (s *IntervalSet[T]) minOf(a, b T) T {
+	if s.compare(a, b) <= 0 {
+		return a
+	}
+	return b
+}
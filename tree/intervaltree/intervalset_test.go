@@ -0,0 +1,116 @@
+package intervaltree_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/intervaltree"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntervalSet_AddMergesRanges(t *testing.T) {
+	s := intervaltree.NewOrderedIntervalSet[int]()
+	assert.True(t, s.IsEmpty())
+
+	s.Add(iv{1, 3}, iv{10, 12}, iv{5, 7})
+	assert.Equal(t, 3, s.Size())
+	// Touching ranges merge, and a wide range swallows those it covers
+	s.Add(iv{3, 5})
+	assert.Equal(t, []iv{{1, 7}, {10, 12}}, s.ToSlice())
+	s.Add(iv{0, 11}, iv{4, 4})
+	assert.Equal(t, []iv{{0, 12}}, s.ToSlice())
+	assert.Equal(t, "IntervalSet : [[0, 12)]", s.ToString())
+
+	assert.True(t, s.Contains(0, 5, 11))
+	assert.False(t, s.Contains(5, 12))
+	assert.True(t, s.Covers(iv{2, 12}))
+	assert.False(t, s.Covers(iv{2, 13}))
+	assert.True(t, s.Overlaps(iv{11, 20}))
+	assert.False(t, s.Overlaps(iv{12, 20}))
+
+	s.Clear()
+	assert.True(t, s.IsEmpty())
+	assert.False(t, s.Contains(1))
+}
+
+func TestIntervalSet_RemoveSplitsRanges(t *testing.T) {
+	s := intervaltree.NewOrderedIntervalSet[int]()
+	s.Add(iv{0, 10}, iv{20, 30}, iv{40, 50})
+
+	s.Remove(iv{3, 5})
+	assert.Equal(t, []iv{{0, 3}, {5, 10}, {20, 30}, {40, 50}}, s.ToSlice())
+	s.Remove(iv{8, 45})
+	assert.Equal(t, []iv{{0, 3}, {5, 8}, {45, 50}}, s.ToSlice())
+	s.Remove(iv{0, 3}, iv{49, 60})
+	assert.Equal(t, []iv{{5, 8}, {45, 49}}, s.ToSlice())
+}
+
+func TestIntervalSet_Algebra(t *testing.T) {
+	a := intervaltree.NewOrderedIntervalSet[int]()
+	a.Add(iv{0, 10}, iv{20, 30})
+	b := intervaltree.NewOrderedIntervalSet[int]()
+	b.Add(iv{5, 20}, iv{25, 35})
+
+	assert.Equal(t, []iv{{0, 35}}, a.Union(b).ToSlice())
+	assert.Equal(t, []iv{{5, 10}, {25, 30}}, a.Intersection(b).ToSlice())
+	assert.Equal(t, []iv{{0, 5}, {20, 25}}, a.Difference(b).ToSlice())
+	assert.Equal(t, []iv{{10, 20}, {30, 35}}, b.Difference(a).ToSlice())
+	// The operands are left untouched
+	assert.Equal(t, []iv{{0, 10}, {20, 30}}, a.ToSlice())
+
+	empty := intervaltree.NewOrderedIntervalSet[int]()
+	assert.Equal(t, a.ToSlice(), a.Union(empty).ToSlice())
+	assert.True(t, a.Intersection(empty).IsEmpty())
+	assert.True(t, empty.Difference(a).IsEmpty())
+}
+
+func TestIntervalSet_RandomOperationsMatchReference(t *testing.T) {
+	const width = 200
+	rng := rand.New(rand.NewSource(1))
+	randomSet := func() (*intervaltree.IntervalSet[int], []bool) {
+		s := intervaltree.NewOrderedIntervalSet[int]()
+		points := make([]bool, width)
+		for i := 0; i < 30; i++ {
+			start := rng.Intn(width)
+			end := min(width, start+rng.Intn(20))
+			add := rng.Intn(3) != 0
+			if add {
+				s.Add(iv{start, end})
+			} else {
+				s.Remove(iv{start, end})
+			}
+			for p := start; p < end; p++ {
+				points[p] = add
+			}
+		}
+		return s, points
+	}
+	check := func(s *intervaltree.IntervalSet[int], points []bool) {
+		ranges := s.ToSlice()
+		for i := 1; i < len(ranges); i++ {
+			assert.Less(t, ranges[i-1].End, ranges[i].Start)
+		}
+		for p := 0; p < width; p++ {
+			assert.Equal(t, points[p], s.Contains(p), "point %d", p)
+		}
+	}
+
+	for round := 0; round < 50; round++ {
+		a, inA := randomSet()
+		b, inB := randomSet()
+		check(a, inA)
+		check(b, inB)
+
+		union := make([]bool, width)
+		intersection := make([]bool, width)
+		difference := make([]bool, width)
+		for p := range union {
+			union[p] = inA[p] || inB[p]
+			intersection[p] = inA[p] && inB[p]
+			difference[p] = inA[p] && !inB[p]
+		}
+		check(a.Union(b), union)
+		check(a.Intersection(b), intersection)
+		check(a.Difference(b), difference)
+	}
+}
@@ -0,0 +1,349 @@
+package intervaltree
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+)
+
+// Interval is the half-open range [Start, End).
+type Interval[T any] struct {
+	Start T
+	End   T
+}
+
+// String formats the interval as [Start, End).
+func (iv Interval[T]) String() string {
+	return fmt.Sprintf("[%v, %v)", iv.Start, iv.End)
+}
+
+type node[T any, V any] struct {
+	interval Interval[T]
+	value    V
+	// maxEnd is the greatest End in the subtree
+	maxEnd T
+	height int
+	left   *node[T, V]
+	right  *node[T, V]
+}
+
+func heightOf[T any, V any](n *node[T, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// Tree is an interval tree: an AVL tree of intervals ordered by start,
+// where every node also stores the greatest end below it. That lets
+// stabbing and overlap queries skip every subtree that ends too early, so
+// they run in O(log n + k) for k results.
+type Tree[T any, V any] struct {
+	root    *node[T, V]
+	size    int
+	compare func(a, b T) int
+}
+
+// New creates an empty Tree whose endpoints are ordered by compare.
+func New[T any, V any](compare func(a, b T) int) *Tree[T, V] {
+	return &Tree[T, V]{compare: compare}
+}
+
+// NewOrdered creates an empty Tree using the natural order of T.
+func NewOrdered[T cmp.Ordered, V any]() *Tree[T, V] {
+	return New[T, V](cmp.Compare[T])
+}
+
+// Len returns the number of intervals in the tree.
+func (t *Tree[T, V]) Len() int {
+	return t.size
+}
+
+// IsEmpty checks if the tree has no intervals.
+func (t *Tree[T, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Clear removes every interval.
+func (t *Tree[T, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// Put inserts interval with value, or replaces the value if the same
+// interval is present. It reports whether interval was inserted. It
+// panics if the interval is empty.
+func (t *Tree[T, V]) Put(interval Interval[T], value V) bool {
+	t.checkInterval(interval)
+	inserted := false
+	t.root = t.insert(t.root, interval, value, &inserted)
+	if inserted {
+		t.size++
+	}
+	return inserted
+}
+
+// Get returns the value stored for interval and whether it was found.
+func (t *Tree[T, V]) Get(interval Interval[T]) (V, bool) {
+	for n := t.root; n != nil; {
+		switch c := t.compareIntervals(interval, n.interval); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.value, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// Delete removes interval and reports whether it was present.
+func (t *Tree[T, V]) Delete(interval Interval[T]) bool {
+	removed := false
+	t.root = t.remove(t.root, interval, &removed)
+	if removed {
+		t.size--
+	}
+	return removed
+}
+
+// Stab calls fn, in order of start, for every interval that contains
+// point until fn returns false. fn must not modify the tree.
+func (t *Tree[T, V]) Stab(point T, fn func(interval Interval[T], value V) bool) {
+	t.search(t.root, point, point, true, fn)
+}
+
+// Overlaps calls fn, in order of start, for every interval that overlaps
+// query until fn returns false. Intervals that only touch query at an
+// endpoint do not overlap it. fn must not modify the tree.
+func (t *Tree[T, V]) Overlaps(query Interval[T], fn func(interval Interval[T], value V) bool) {
+	if t.compare(query.Start, query.End) < 0 {
+		t.search(t.root, query.Start, query.End, false, fn)
+	}
+}
+
+// AnyOverlap returns some interval that overlaps query, if there is one,
+// in O(log n).
+func (t *Tree[T, V]) AnyOverlap(query Interval[T]) (Interval[T], V, bool) {
+	for n := t.root; n != nil && t.compare(query.Start, query.End) < 0; {
+		if t.compare(n.interval.Start, query.End) < 0 && t.compare(query.Start, n.interval.End) < 0 {
+			return n.interval, n.value, true
+		}
+		// If anything on the left reaches past the query start, the
+		// leftmost such interval overlaps or nothing to the right does
+		if n.left != nil && t.compare(n.left.maxEnd, query.Start) > 0 {
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	var zero V
+	return Interval[T]{}, zero, false
+}
+
+// Each calls fn for every interval in order of start until fn returns
+// false. fn must not modify the tree.
+func (t *Tree[T, V]) Each(fn func(interval Interval[T], value V) bool) {
+	each(t.root, fn)
+}
+
+// Intervals returns the intervals in order of start.
+func (t *Tree[T, V]) Intervals() []Interval[T] {
+	intervals := make([]Interval[T], 0, t.size)
+	t.Each(func(interval Interval[T], _ V) bool {
+		intervals = append(intervals, interval)
+		return true
+	})
+	return intervals
+}
+
+// Validate checks the interval order, the stored heights and maximum
+// ends, the AVL balance and the size. It is meant for tests.
+func (t *Tree[T, V]) Validate() error {
+	count := 0
+	if _, err := t.validate(t.root, &count); err != nil {
+		return err
+	}
+	if count != t.size {
+		return fmt.Errorf("intervaltree: size is %d but tree holds %d nodes", t.size, count)
+	}
+	var prev *Interval[T]
+	var err error
+	t.Each(func(interval Interval[T], _ V) bool {
+		if prev != nil && t.compareIntervals(*prev, interval) >= 0 {
+			err = fmt.Errorf("intervaltree: intervals %v and %v out of order", *prev, interval)
+			return false
+		}
+		prev = &interval
+		return true
+	})
+	return err
+}
+
+func (t *Tree[T, V]) validate(n *node[T, V], count *int) (int, error) {
+	if n == nil {
+		return 0, nil
+	}
+	*count++
+	left, err := t.validate(n.left, count)
+	if err != nil {
+		return 0, err
+	}
+	right, err := t.validate(n.right, count)
+	if err != nil {
+		return 0, err
+	}
+	if left-right > 1 || right-left > 1 {
+		return 0, fmt.Errorf("intervaltree: node %v is out of balance (%d, %d)", n.interval, left, right)
+	}
+	if max(left, right)+1 != n.height {
+		return 0, fmt.Errorf("intervaltree: node %v stores a wrong height", n.interval)
+	}
+	maxEnd := n.interval.End
+	for _, child := range []*node[T, V]{n.left, n.right} {
+		if child != nil && t.compare(child.maxEnd, maxEnd) > 0 {
+			maxEnd = child.maxEnd
+		}
+	}
+	if t.compare(maxEnd, n.maxEnd) != 0 {
+		return 0, errors.New("intervaltree: wrong maximum end")
+	}
+	return n.height, nil
+}
+
+func (t *Tree[T, V]) checkInterval(interval Interval[T]) {
+	if t.compare(interval.Start, interval.End) >= 0 {
+		panic(fmt.Sprintf("intervaltree: invalid interval %v", interval))
+	}
+}
+
+// compareIntervals orders intervals by start, then by end.
+func (t *Tree[T, V]) compareIntervals(a, b Interval[T]) int {
+	if c := t.compare(a.Start, b.Start); c != 0 {
+		return c
+	}
+	return t.compare(a.End, b.End)
+}
+
+// search reports the intervals below n that overlap [from, to), or that
+// contain from when stab is set, and whether the walk should continue.
+func (t *Tree[T, V]) search(n *node[T, V], from, to T, stab bool, fn func(interval Interval[T], value V) bool) bool {
+	// Nothing below n ends after from
+	if n == nil || t.compare(n.maxEnd, from) <= 0 {
+		return true
+	}
+	if !t.search(n.left, from, to, stab, fn) {
+		return false
+	}
+	c := t.compare(n.interval.Start, to)
+	if c > 0 || (c == 0 && !stab) {
+		// This interval and everything to its right start too late
+		return true
+	}
+	if t.compare(from, n.interval.End) < 0 && !fn(n.interval, n.value) {
+		return false
+	}
+	return t.search(n.right, from, to, stab, fn)
+}
+
+func (t *Tree[T, V]) update(n *node[T, V]) {
+	n.height = max(heightOf(n.left), heightOf(n.right)) + 1
+	n.maxEnd = n.interval.End
+	if n.left != nil && t.compare(n.left.maxEnd, n.maxEnd) > 0 {
+		n.maxEnd = n.left.maxEnd
+	}
+	if n.right != nil && t.compare(n.right.maxEnd, n.maxEnd) > 0 {
+		n.maxEnd = n.right.maxEnd
+	}
+}
+
+func (t *Tree[T, V]) insert(n *node[T, V], interval Interval[T], value V, inserted *bool) *node[T, V] {
+	if n == nil {
+		*inserted = true
+		return &node[T, V]{interval: interval, value: value, maxEnd: interval.End, height: 1}
+	}
+	switch c := t.compareIntervals(interval, n.interval); {
+	case c < 0:
+		n.left = t.insert(n.left, interval, value, inserted)
+	case c > 0:
+		n.right = t.insert(n.right, interval, value, inserted)
+	default:
+		n.value = value
+		return n
+	}
+	return t.rebalance(n)
+}
+
+func (t *Tree[T, V]) remove(n *node[T, V], interval Interval[T], removed *bool) *node[T, V] {
+	if n == nil {
+		return nil
+	}
+	switch c := t.compareIntervals(interval, n.interval); {
+	case c < 0:
+		n.left = t.remove(n.left, interval, removed)
+	case c > 0:
+		n.right = t.remove(n.right, interval, removed)
+	default:
+		*removed = true
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+		// Replace with the successor and remove that from the right
+		succ := n.right
+		for succ.left != nil {
+			succ = succ.left
+		}
+		n.interval, n.value = succ.interval, succ.value
+		n.right = t.remove(n.right, succ.interval, new(bool))
+	}
+	return t.rebalance(n)
+}
+
+// rebalance restores the AVL balance of n with at most two rotations and
+// returns the root of its subtree.
+func (t *Tree[T, V]) rebalance(n *node[T, V]) *node[T, V] {
+	t.update(n)
+	switch balance := heightOf(n.left) - heightOf(n.right); {
+	case balance > 1:
+		if heightOf(n.left.left) < heightOf(n.left.right) {
+			n.left = t.rotateLeft(n.left)
+		}
+		return t.rotateRight(n)
+	case balance < -1:
+		if heightOf(n.right.right) < heightOf(n.right.left) {
+			n.right = t.rotateRight(n.right)
+		}
+		return t.rotateLeft(n)
+	}
+	return n
+}
+
+func (t *Tree[T, V]) rotateLeft(x *node[T, V]) *node[T, V] {
+	y := x.right
+	x.right = y.left
+	y.left = x
+	t.update(x)
+	t.update(y)
+	return y
+}
+
+func (t *Tree[T, V]) rotateRight(x *node[T, V]) *node[T, V] {
+	y := x.left
+	x.left = y.right
+	y.right = x
+	t.update(x)
+	t.update(y)
+	return y
+}
+
+func each[T any, V any](n *node[T, V], fn func(interval Interval[T], value V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return each(n.left, fn) && fn(n.interval, n.value) && each(n.right, fn)
+}
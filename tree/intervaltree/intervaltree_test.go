@@ -0,0 +1,143 @@
+package intervaltree_test
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/intervaltree"
+	"github.com/stretchr/testify/assert"
+)
+
+type iv = intervaltree.Interval[int]
+
+func collect(query func(fn func(interval iv, value string) bool)) []iv {
+	var result []iv
+	query(func(interval iv, _ string) bool {
+		result = append(result, interval)
+		return true
+	})
+	return result
+}
+
+func TestTree_BasicOperations(t *testing.T) {
+	tree := intervaltree.NewOrdered[int, string]()
+	assert.True(t, tree.IsEmpty())
+
+	assert.True(t, tree.Put(iv{5, 10}, "a"))
+	assert.True(t, tree.Put(iv{1, 3}, "b"))
+	assert.True(t, tree.Put(iv{8, 20}, "c"))
+	assert.True(t, tree.Put(iv{5, 7}, "d"))
+	assert.False(t, tree.Put(iv{5, 10}, "A"))
+	assert.Equal(t, 4, tree.Len())
+
+	value, ok := tree.Get(iv{5, 10})
+	assert.True(t, ok)
+	assert.Equal(t, "A", value)
+	_, ok = tree.Get(iv{5, 11})
+	assert.False(t, ok)
+	assert.Equal(t, []iv{{1, 3}, {5, 7}, {5, 10}, {8, 20}}, tree.Intervals())
+
+	assert.True(t, tree.Delete(iv{5, 7}))
+	assert.False(t, tree.Delete(iv{5, 7}))
+	assert.Equal(t, 3, tree.Len())
+	assert.NoError(t, tree.Validate())
+
+	assert.Panics(t, func() { tree.Put(iv{3, 3}, "") })
+	tree.Clear()
+	assert.True(t, tree.IsEmpty())
+}
+
+func TestTree_StabAndOverlaps(t *testing.T) {
+	tree := intervaltree.NewOrdered[int, string]()
+	for _, interval := range []iv{{1, 3}, {5, 10}, {8, 20}, {12, 15}, {30, 40}} {
+		tree.Put(interval, "")
+	}
+
+	assert.Equal(t, []iv{{5, 10}, {8, 20}}, collect(func(fn func(iv, string) bool) { tree.Stab(9, fn) }))
+	// Ends are exclusive and starts are inclusive
+	assert.Equal(t, []iv{{8, 20}}, collect(func(fn func(iv, string) bool) { tree.Stab(10, fn) }))
+	assert.Equal(t, []iv{{30, 40}}, collect(func(fn func(iv, string) bool) { tree.Stab(30, fn) }))
+	assert.Nil(t, collect(func(fn func(iv, string) bool) { tree.Stab(3, fn) }))
+
+	assert.Equal(t, []iv{{5, 10}, {8, 20}, {12, 15}},
+		collect(func(fn func(iv, string) bool) { tree.Overlaps(iv{9, 13}, fn) }))
+	// Touching at an endpoint is not an overlap
+	assert.Nil(t, collect(func(fn func(iv, string) bool) { tree.Overlaps(iv{20, 30}, fn) }))
+	assert.Equal(t, []iv{{1, 3}}, collect(func(fn func(iv, string) bool) { tree.Overlaps(iv{0, 5}, fn) }))
+
+	var first []iv
+	tree.Overlaps(iv{0, 100}, func(interval iv, _ string) bool {
+		first = append(first, interval)
+		return len(first) < 2
+	})
+	assert.Equal(t, []iv{{1, 3}, {5, 10}}, first)
+
+	found, _, ok := tree.AnyOverlap(iv{14, 16})
+	assert.True(t, ok)
+	assert.Contains(t, []iv{{8, 20}, {12, 15}}, found)
+	_, _, ok = tree.AnyOverlap(iv{20, 30})
+	assert.False(t, ok)
+}
+
+func TestTree_RandomOperationsMatchReference(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	tree := intervaltree.NewOrdered[int, int]()
+	reference := map[iv]int{}
+
+	for i := 0; i < 4000; i++ {
+		start := rng.Intn(300)
+		interval := iv{start, start + 1 + rng.Intn(40)}
+		if rng.Intn(3) == 0 {
+			_, exists := reference[interval]
+			assert.Equal(t, exists, tree.Delete(interval))
+			delete(reference, interval)
+		} else {
+			_, exists := reference[interval]
+			assert.Equal(t, !exists, tree.Put(interval, i))
+			reference[interval] = i
+		}
+
+		if i%50 == 0 {
+			if err := tree.Validate(); err != nil {
+				t.Fatal(err)
+			}
+			from := rng.Intn(340)
+			query := iv{from, from + 1 + rng.Intn(20)}
+			var expected, stabbed []iv
+			for interval := range reference {
+				if interval.Start < query.End && query.Start < interval.End {
+					expected = append(expected, interval)
+				}
+				if interval.Start <= from && from < interval.End {
+					stabbed = append(stabbed, interval)
+				}
+			}
+			byStart := func(a, b iv) int {
+				if a.Start != b.Start {
+					return a.Start - b.Start
+				}
+				return a.End - b.End
+			}
+			slices.SortFunc(expected, byStart)
+			slices.SortFunc(stabbed, byStart)
+
+			var got []iv
+			tree.Overlaps(query, func(interval iv, _ int) bool {
+				got = append(got, interval)
+				return true
+			})
+			assert.Equal(t, expected, got)
+			got = nil
+			tree.Stab(from, func(interval iv, _ int) bool {
+				got = append(got, interval)
+				return true
+			})
+			assert.Equal(t, stabbed, got)
+			_, _, ok := tree.AnyOverlap(query)
+			assert.Equal(t, len(expected) > 0, ok)
+		}
+	}
+	assert.Equal(t, len(reference), tree.Len())
+	assert.NoError(t, tree.Validate())
+}
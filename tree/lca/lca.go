@@ -0,0 +1,213 @@
+package lca
+
+import (
+	"fmt"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/sparsetable"
+)
+
+// tour holds a depth-first walk of a rooted tree given as an adjacency
+// list. Vertices not reachable from the root have depth -1.
+type tour struct {
+	parent []int
+	depth  []int
+	// euler lists the vertices as the walk enters and returns to them
+	euler []int
+	first []int
+}
+
+// walk runs an iterative depth-first search from root, so deep trees do
+// not need a deep call stack. adj must list each edge from parent to
+// child or in both directions; edges back to visited vertices are
+// ignored, and vertices only reachable through child-to-parent edges
+// are never visited.
+func walk(adj [][]int, root int) *tour {
+	n := len(adj)
+	if root < 0 || root >= n {
+		panic(fmt.Sprintf("lca: root %d out of range with size %d", root, n))
+	}
+	t := &tour{
+		parent: make([]int, n),
+		depth:  make([]int, n),
+		euler:  make([]int, 0, 2*n-1),
+		first:  make([]int, n),
+	}
+	for v := range t.depth {
+		t.parent[v], t.depth[v], t.first[v] = -1, -1, -1
+	}
+	t.depth[root] = 0
+	t.first[root] = 0
+	t.euler = append(t.euler, root)
+
+	// next[i] is the index of the next neighbour to visit of stack[i]
+	stack, next := []int{root}, []int{0}
+	for len(stack) > 0 {
+		top := len(stack) - 1
+		v := stack[top]
+		if next[top] == len(adj[v]) {
+			stack, next = stack[:top], next[:top]
+			if top > 0 {
+				t.euler = append(t.euler, stack[top-1])
+			}
+			continue
+		}
+		w := adj[v][next[top]]
+		next[top]++
+		if w < 0 || w >= n {
+			panic(fmt.Sprintf("lca: vertex %d out of range with size %d", w, n))
+		}
+		if t.depth[w] != -1 {
+			continue
+		}
+		t.parent[w] = v
+		t.depth[w] = t.depth[v] + 1
+		t.first[w] = len(t.euler)
+		t.euler = append(t.euler, w)
+		stack, next = append(stack, w), append(next, 0)
+	}
+	return t
+}
+
+func checkVertex(v, n int) {
+	if v < 0 || v >= n {
+		panic(fmt.Sprintf("lca: vertex %d out of range with size %d", v, n))
+	}
+}
+
+// Euler answers lowest common ancestor queries in O(1) after O(n log n)
+// preprocessing. The lowest common ancestor of u and v is the shallowest
+// vertex visited between them in an Euler tour of the tree, which a
+// sparse table finds as a range minimum.
+type Euler struct {
+	depth []int
+	first []int
+	table *sparsetable.Table[int]
+}
+
+// NewEuler prepares LCA queries for the tree rooted at root whose edges
+// are given by the adjacency list adj, listed from parent to child or in
+// both directions. It panics if a vertex is out of range.
+func NewEuler(adj [][]int, root int) *Euler {
+	t := walk(adj, root)
+	shallower := func(a, b int) int {
+		if t.depth[b] < t.depth[a] {
+			return b
+		}
+		return a
+	}
+	return &Euler{depth: t.depth, first: t.first, table: sparsetable.New(t.euler, shallower)}
+}
+
+// LCA returns the lowest common ancestor of u and v, or -1 if either is
+// not reachable from the root. It panics if a vertex is out of range.
+func (e *Euler) LCA(u, v int) int {
+	checkVertex(u, len(e.depth))
+	checkVertex(v, len(e.depth))
+	if e.depth[u] < 0 || e.depth[v] < 0 {
+		return -1
+	}
+	from, to := e.first[u], e.first[v]
+	if from > to {
+		from, to = to, from
+	}
+	return e.table.Query(from, to+1)
+}
+
+// Depth returns the number of edges from the root to v, or -1 if v is
+// not reachable. It panics if v is out of range.
+func (e *Euler) Depth(v int) int {
+	checkVertex(v, len(e.depth))
+	return e.depth[v]
+}
+
+// Distance returns the number of edges between u and v, or -1 if either
+// is not reachable from the root. It panics if a vertex is out of range.
+func (e *Euler) Distance(u, v int) int {
+	return distance(e.LCA(u, v), e.depth, u, v)
+}
+
+// Lifting answers lowest common ancestor and k-th ancestor queries in
+// O(log n) after O(n log n) preprocessing, by storing for every vertex
+// its ancestors 1, 2, 4, ... levels up.
+type Lifting struct {
+	depth []int
+	up    [][]int
+}
+
+// NewLifting prepares ancestor queries for the tree rooted at root whose
+// edges are given by the adjacency list adj, listed from parent to child
+// or in both directions. It panics if a vertex is out of range.
+func NewLifting(adj [][]int, root int) *Lifting {
+	t := walk(adj, root)
+	l := &Lifting{depth: t.depth, up: [][]int{t.parent}}
+	for span := 2; span < len(adj); span *= 2 {
+		prev := l.up[len(l.up)-1]
+		level := make([]int, len(adj))
+		for v, mid := range prev {
+			level[v] = -1
+			if mid >= 0 {
+				level[v] = prev[mid]
+			}
+		}
+		l.up = append(l.up, level)
+	}
+	return l
+}
+
+// Ancestor returns the ancestor k levels above v, v itself for k = 0, or
+// -1 if there is none. It panics if v is out of range.
+func (l *Lifting) Ancestor(v, k int) int {
+	checkVertex(v, len(l.depth))
+	if k < 0 || k > l.depth[v] {
+		return -1
+	}
+	for level := 0; k > 0; level, k = level+1, k>>1 {
+		if k&1 == 1 {
+			v = l.up[level][v]
+		}
+	}
+	return v
+}
+
+// LCA returns the lowest common ancestor of u and v, or -1 if either is
+// not reachable from the root. It panics if a vertex is out of range.
+func (l *Lifting) LCA(u, v int) int {
+	checkVertex(u, len(l.depth))
+	checkVertex(v, len(l.depth))
+	if l.depth[u] < 0 || l.depth[v] < 0 {
+		return -1
+	}
+	if l.depth[u] < l.depth[v] {
+		u, v = v, u
+	}
+	u = l.Ancestor(u, l.depth[u]-l.depth[v])
+	if u == v {
+		return u
+	}
+	for level := len(l.up) - 1; level >= 0; level-- {
+		if a, b := l.up[level][u], l.up[level][v]; a != b {
+			u, v = a, b
+		}
+	}
+	return l.up[0][u]
+}
+
+// Depth returns the number of edges from the root to v, or -1 if v is
+// not reachable. It panics if v is out of range.
+func (l *Lifting) Depth(v int) int {
+	checkVertex(v, len(l.depth))
+	return l.depth[v]
+}
+
+// Distance returns the number of edges between u and v, or -1 if either
+// is not reachable from the root. It panics if a vertex is out of range.
+func (l *Lifting) Distance(u, v int) int {
+	return distance(l.LCA(u, v), l.depth, u, v)
+}
+
+func distance(ancestor int, depth []int, u, v int) int {
+	if ancestor < 0 {
+		return -1
+	}
+	return depth[u] + depth[v] - 2*depth[ancestor]
+}
@@ -0,0 +1,139 @@
+package lca_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/lca"
+	"github.com/stretchr/testify/assert"
+)
+
+type finder interface {
+	LCA(u, v int) int
+	Depth(v int) int
+	Distance(u, v int) int
+}
+
+var finders = []struct {
+	name string
+	new  func(adj [][]int, root int) finder
+}{
+	{"Euler", func(adj [][]int, root int) finder { return lca.NewEuler(adj, root) }},
+	{"Lifting", func(adj [][]int, root int) finder { return lca.NewLifting(adj, root) }},
+}
+
+// undirected builds an adjacency list listing every edge both ways.
+func undirected(n int, edges [][2]int) [][]int {
+	adj := make([][]int, n)
+	for _, e := range edges {
+		adj[e[0]] = append(adj[e[0]], e[1])
+		adj[e[1]] = append(adj[e[1]], e[0])
+	}
+	return adj
+}
+
+func TestLCA_SmallTree(t *testing.T) {
+	//        0
+	//      /   \
+	//     1     2
+	//    / \     \
+	//   3   4     5
+	//       |
+	//       6          7 is not connected
+	adj := undirected(8, [][2]int{{0, 1}, {0, 2}, {1, 3}, {1, 4}, {2, 5}, {4, 6}})
+	for _, tc := range finders {
+		t.Run(tc.name, func(t *testing.T) {
+			f := tc.new(adj, 0)
+			assert.Equal(t, 1, f.LCA(3, 6))
+			assert.Equal(t, 0, f.LCA(6, 5))
+			assert.Equal(t, 4, f.LCA(4, 6))
+			assert.Equal(t, 2, f.LCA(2, 2))
+			assert.Equal(t, 3, f.Depth(6))
+			assert.Equal(t, 5, f.Distance(6, 5))
+			assert.Equal(t, 0, f.Distance(3, 3))
+
+			assert.Equal(t, -1, f.LCA(7, 0))
+			assert.Equal(t, -1, f.Depth(7))
+			assert.Equal(t, -1, f.Distance(7, 3))
+			assert.Panics(t, func() { f.LCA(8, 0) })
+		})
+	}
+	assert.Panics(t, func() { lca.NewEuler(adj, 8) })
+}
+
+func TestLCA_ChildListsAndOtherRoot(t *testing.T) {
+	// Edges listed only from parent to child, rooted at 2
+	adj := [][]int{{}, {0}, {1, 3}, {}}
+	for _, tc := range finders {
+		t.Run(tc.name, func(t *testing.T) {
+			f := tc.new(adj, 2)
+			assert.Equal(t, 2, f.LCA(0, 3))
+			assert.Equal(t, 1, f.LCA(0, 1))
+			assert.Equal(t, 2, f.Depth(0))
+		})
+	}
+}
+
+func TestLifting_Ancestor(t *testing.T) {
+	adj := undirected(5, [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 4}})
+	l := lca.NewLifting(adj, 0)
+	assert.Equal(t, 4, l.Ancestor(4, 0))
+	assert.Equal(t, 3, l.Ancestor(4, 1))
+	assert.Equal(t, 0, l.Ancestor(4, 4))
+	assert.Equal(t, -1, l.Ancestor(4, 5))
+	assert.Equal(t, -1, l.Ancestor(4, -1))
+}
+
+func TestLCA_RandomTrees(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 10, 300} {
+		parent := make([]int, n)
+		var edges [][2]int
+		parent[0] = -1
+		for v := 1; v < n; v++ {
+			parent[v] = rng.Intn(v)
+			edges = append(edges, [2]int{parent[v], v})
+		}
+		depth := make([]int, n)
+		for v := 1; v < n; v++ {
+			depth[v] = depth[parent[v]] + 1
+		}
+		naive := func(u, v int) int {
+			for depth[u] > depth[v] {
+				u = parent[u]
+			}
+			for depth[v] > depth[u] {
+				v = parent[v]
+			}
+			for u != v {
+				u, v = parent[u], parent[v]
+			}
+			return u
+		}
+
+		adj := undirected(n, edges)
+		euler, lifting := lca.NewEuler(adj, 0), lca.NewLifting(adj, 0)
+		for i := 0; i < 500; i++ {
+			u, v := rng.Intn(n), rng.Intn(n)
+			want := naive(u, v)
+			assert.Equal(t, want, euler.LCA(u, v))
+			assert.Equal(t, want, lifting.LCA(u, v))
+		}
+	}
+}
+
+func TestLCA_DeepPath(t *testing.T) {
+	// A long path reaches the top levels of the lifting table
+	const n = 200000
+	adj := make([][]int, n)
+	for v := 1; v < n; v++ {
+		adj[v-1] = append(adj[v-1], v)
+	}
+	for _, tc := range finders {
+		t.Run(tc.name, func(t *testing.T) {
+			f := tc.new(adj, 0)
+			assert.Equal(t, 1000, f.LCA(1000, n-1))
+			assert.Equal(t, n-1, f.Depth(n-1))
+		})
+	}
+}
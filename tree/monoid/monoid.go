@@ -0,0 +1,120 @@
+package monoid
+
+import "cmp"
+
+// Number is the set of built-in numeric types.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Monoid is an associative Combine with an Identity element, so that the
+// aggregate of any range can be built from the aggregates of its parts.
+type Monoid[T any] struct {
+	Identity T
+	Combine  func(a, b T) T
+}
+
+// Action is a lazy update of type U applied to values of type T.
+// Apply must distribute over the monoid it is used with: updating the
+// aggregate of size values must give the same result as updating each
+// value and combining them.
+type Action[T any, U any] struct {
+	// Apply returns the aggregate of size values after u is applied.
+	Apply func(u U, aggregate T, size int) T
+
+	// Compose merges two updates into one that applies older first and
+	// then newer.
+	Compose func(newer, older U) U
+}
+
+// Sum returns the monoid of addition.
+func Sum[T Number]() Monoid[T] {
+	return Monoid[T]{Combine: func(a, b T) T { return a + b }}
+}
+
+// Min returns the monoid of the minimum. The identity must not be less
+// than any value, such as math.MaxInt or +Inf.
+func Min[T cmp.Ordered](identity T) Monoid[T] {
+	return Monoid[T]{Identity: identity, Combine: func(a, b T) T { return min(a, b) }}
+}
+
+// Max returns the monoid of the maximum. The identity must not be greater
+// than any value, such as math.MinInt or -Inf.
+func Max[T cmp.Ordered](identity T) Monoid[T] {
+	return Monoid[T]{Identity: identity, Combine: func(a, b T) T { return max(a, b) }}
+}
+
+// AddToSum adds u to every value of a range aggregated with Sum.
+func AddToSum[T Number]() Action[T, T] {
+	return Action[T, T]{
+		Apply:   func(u T, aggregate T, size int) T { return aggregate + u*T(size) },
+		Compose: func(newer, older T) T { return newer + older },
+	}
+}
+
+// AssignToSum sets every value of a range aggregated with Sum to u.
+func AssignToSum[T Number]() Action[T, T] {
+	return Action[T, T]{
+		Apply:   func(u T, _ T, size int) T { return u * T(size) },
+		Compose: func(newer, _ T) T { return newer },
+	}
+}
+
+// AddToExtreme adds u to every value of a range aggregated with Min or
+// Max.
+func AddToExtreme[T Number]() Action[T, T] {
+	return Action[T, T]{
+		Apply:   func(u T, aggregate T, _ int) T { return aggregate + u },
+		Compose: func(newer, older T) T { return newer + older },
+	}
+}
+
+// AssignToExtreme sets every value of a range aggregated with Min or Max
+// to u.
+func AssignToExtreme[T any]() Action[T, T] {
+	return Action[T, T]{
+		Apply:   func(u T, _ T, _ int) T { return u },
+		Compose: func(newer, _ T) T { return newer },
+	}
+}
+
+// Group is a Monoid in which every value has an Inverse, so that a range
+// aggregate can be recovered from two prefix aggregates.
+type Group[T any] struct {
+	Monoid[T]
+	Inverse func(a T) T
+
+	// Scale, when set, returns a combined with itself k times. It lets
+	// Times skip the repeated doubling.
+	Scale func(a T, k int) T
+}
+
+// Times returns a combined with itself k times, or the inverse of that
+// when k is negative.
+func (g Group[T]) Times(a T, k int) T {
+	if g.Scale != nil {
+		return g.Scale(a, k)
+	}
+	if k < 0 {
+		a, k = g.Inverse(a), -k
+	}
+	result := g.Identity
+	for ; k > 0; k >>= 1 {
+		if k&1 == 1 {
+			result = g.Combine(result, a)
+		}
+		a = g.Combine(a, a)
+	}
+	return result
+}
+
+// Additive returns the group of addition.
+func Additive[T Number]() Group[T] {
+	return Group[T]{
+		Monoid:  Sum[T](),
+		Inverse: func(a T) T { return -a },
+		Scale:   func(a T, k int) T { return a * T(k) },
+	}
+}
@@ -0,0 +1,47 @@
+package rbtree
+
+import "fmt"
+
+// Preorder returns the keys in preorder with a * after the red ones, which
+// exposes the shape and the colouring of the tree to the tests.
+func (t *Tree[K, V]) Preorder() []string {
+	var keys []string
+	var walk func(n *Node[K, V])
+	walk = func(n *Node[K, V]) {
+		if n != nil {
+			key := fmt.Sprint(n.key)
+			if n.color == red {
+				key += "*"
+			}
+			keys = append(keys, key)
+			walk(n.left)
+			walk(n.right)
+		}
+	}
+	walk(t.root)
+	return keys
+}
+
+// Height returns the number of nodes on the longest path from the root.
+func (t *Tree[K, V]) Height() int {
+	var height func(n *Node[K, V]) int
+	height = func(n *Node[K, V]) int {
+		if n == nil {
+			return 0
+		}
+		return max(height(n.left), height(n.right)) + 1
+	}
+	return height(t.root)
+}
+
+// BlackHeight returns the number of black nodes on the leftmost path,
+// which Validate checks to be the same on every path.
+func (t *Tree[K, V]) BlackHeight() int {
+	height := 0
+	for n := t.root; n != nil; n = n.left {
+		if n.color == black {
+			height++
+		}
+	}
+	return height
+}
@@ -0,0 +1,561 @@
+package rbtree
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+)
+
+type color bool
+
+const (
+	red   color = false
+	black color = true
+)
+
+// Node is an entry of the tree. It stays valid while the entry is in the
+// tree, across other insertions and deletions, so it can be used to walk
+// the tree in key order or to remove the entry without searching.
+type Node[K any, V any] struct {
+	key    K
+	value  V
+	left   *Node[K, V]
+	right  *Node[K, V]
+	parent *Node[K, V]
+	color  color
+}
+
+// Key returns the key of the entry.
+func (n *Node[K, V]) Key() K {
+	return n.key
+}
+
+// Value returns the value of the entry.
+func (n *Node[K, V]) Value() V {
+	return n.value
+}
+
+// SetValue replaces the value of the entry.
+func (n *Node[K, V]) SetValue(value V) {
+	n.value = value
+}
+
+// Next returns the node with the next greater key, or nil.
+func (n *Node[K, V]) Next() *Node[K, V] {
+	if n.right != nil {
+		return minimum(n.right)
+	}
+	p := n.parent
+	for p != nil && n == p.right {
+		n, p = p, p.parent
+	}
+	return p
+}
+
+// Prev returns the node with the next smaller key, or nil.
+func (n *Node[K, V]) Prev() *Node[K, V] {
+	if n.left != nil {
+		return maximum(n.left)
+	}
+	p := n.parent
+	for p != nil && n == p.left {
+		n, p = p, p.parent
+	}
+	return p
+}
+
+// Tree is a red-black tree ordered by a comparator. Its height stays
+// within 2·log2(n+1), so searches, insertions and deletions are O(log n),
+// and it rotates less than an AVL tree on updates.
+type Tree[K any, V any] struct {
+	root    *Node[K, V]
+	size    int
+	compare func(a, b K) int
+}
+
+// New creates an empty Tree ordered by compare, which must return a
+// negative number, zero or a positive number when a is less than, equal
+// to or greater than b.
+func New[K any, V any](compare func(a, b K) int) *Tree[K, V] {
+	return &Tree[K, V]{compare: compare}
+}
+
+// NewOrdered creates an empty Tree using the natural order of K.
+func NewOrdered[K cmp.Ordered, V any]() *Tree[K, V] {
+	return New[K, V](cmp.Compare[K])
+}
+
+// Comparator returns the function that orders the keys.
+func (t *Tree[K, V]) Comparator() func(a, b K) int {
+	return t.compare
+}
+
+// Len returns the number of entries in the tree.
+func (t *Tree[K, V]) Len() int {
+	return t.size
+}
+
+// IsEmpty checks if the tree has no entries.
+func (t *Tree[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Clear removes every entry.
+func (t *Tree[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// Find returns the node holding key, or nil.
+func (t *Tree[K, V]) Find(key K) *Node[K, V] {
+	n := t.root
+	for n != nil {
+		c := t.compare(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+// Get returns the value for key and whether it was found.
+func (t *Tree[K, V]) Get(key K) (V, bool) {
+	if n := t.Find(key); n != nil {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains checks if key is in the tree.
+func (t *Tree[K, V]) Contains(key K) bool {
+	return t.Find(key) != nil
+}
+
+// Put inserts key with value, or replaces the value if key is present.
+// It reports whether key was inserted.
+func (t *Tree[K, V]) Put(key K, value V) bool {
+	var parent *Node[K, V]
+	n := t.root
+	c := 0
+	for n != nil {
+		parent = n
+		c = t.compare(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			n.value = value
+			return false
+		}
+	}
+	n = &Node[K, V]{key: key, value: value, parent: parent, color: red}
+	switch {
+	case parent == nil:
+		t.root = n
+	case c < 0:
+		parent.left = n
+	default:
+		parent.right = n
+	}
+	t.size++
+	t.insertFixup(n)
+	return true
+}
+
+// Delete removes key and reports whether it was present.
+func (t *Tree[K, V]) Delete(key K) bool {
+	n := t.Find(key)
+	if n == nil {
+		return false
+	}
+	t.Remove(n)
+	return true
+}
+
+// Remove unlinks the node z, which must belong to the tree.
+// Other nodes keep their identity.
+func (t *Tree[K, V]) Remove(z *Node[K, V]) {
+	y := z
+	removedColor := y.color
+	var x, xParent *Node[K, V]
+	switch {
+	case z.left == nil:
+		x, xParent = z.right, z.parent
+		t.transplant(z, z.right)
+	case z.right == nil:
+		x, xParent = z.left, z.parent
+		t.transplant(z, z.left)
+	default:
+		y = minimum(z.right)
+		removedColor = y.color
+		x = y.right
+		if y.parent == z {
+			xParent = y
+		} else {
+			xParent = y.parent
+			t.transplant(y, y.right)
+			y.right = z.right
+			y.right.parent = y
+		}
+		t.transplant(z, y)
+		y.left = z.left
+		y.left.parent = y
+		y.color = z.color
+	}
+	t.size--
+	if removedColor == black {
+		t.deleteFixup(x, xParent)
+	}
+}
+
+// First returns the node with the lowest key, or nil.
+func (t *Tree[K, V]) First() *Node[K, V] {
+	if t.root == nil {
+		return nil
+	}
+	return minimum(t.root)
+}
+
+// Last returns the node with the highest key, or nil.
+func (t *Tree[K, V]) Last() *Node[K, V] {
+	if t.root == nil {
+		return nil
+	}
+	return maximum(t.root)
+}
+
+// Floor returns the node with the greatest key less than or equal to key,
+// or nil.
+func (t *Tree[K, V]) Floor(key K) *Node[K, V] {
+	return t.floor(key, true)
+}
+
+// Lower returns the node with the greatest key strictly less than key,
+// or nil.
+func (t *Tree[K, V]) Lower(key K) *Node[K, V] {
+	return t.floor(key, false)
+}
+
+// Ceiling returns the node with the least key greater than or equal to
+// key, or nil.
+func (t *Tree[K, V]) Ceiling(key K) *Node[K, V] {
+	return t.ceiling(key, true)
+}
+
+// Higher returns the node with the least key strictly greater than key,
+// or nil.
+func (t *Tree[K, V]) Higher(key K) *Node[K, V] {
+	return t.ceiling(key, false)
+}
+
+// Iterator returns an iterator over the nodes in ascending key order.
+func (t *Tree[K, V]) Iterator() *Iterator[K, V] {
+	return &Iterator[K, V]{next: t.First()}
+}
+
+// Each calls fn for every entry in key order until fn returns false.
+// fn must not modify the tree.
+func (t *Tree[K, V]) Each(fn func(key K, value V) bool) {
+	for n := t.First(); n != nil; n = n.Next() {
+		if !fn(n.key, n.value) {
+			return
+		}
+	}
+}
+
+// Keys returns the keys in order.
+func (t *Tree[K, V]) Keys() []K {
+	keys := make([]K, 0, t.size)
+	for n := t.First(); n != nil; n = n.Next() {
+		keys = append(keys, n.key)
+	}
+	return keys
+}
+
+// Validate checks the binary search tree order, the parent links, the
+// size and the red-black properties: a black root, no red node with a red
+// child and the same number of black nodes on every path to a leaf.
+// It is meant for tests.
+func (t *Tree[K, V]) Validate() error {
+	if t.root == nil {
+		if t.size != 0 {
+			return fmt.Errorf("rbtree: empty tree has size %d", t.size)
+		}
+		return nil
+	}
+	if t.root.parent != nil {
+		return errors.New("rbtree: root has a parent")
+	}
+	if t.root.color != black {
+		return errors.New("rbtree: root is red")
+	}
+	count := 0
+	if _, err := t.validate(t.root, &count); err != nil {
+		return err
+	}
+	if count != t.size {
+		return fmt.Errorf("rbtree: size is %d but tree holds %d nodes", t.size, count)
+	}
+	for n := t.First(); n != nil; n = n.Next() {
+		if next := n.Next(); next != nil && t.compare(n.key, next.key) >= 0 {
+			return fmt.Errorf("rbtree: keys %v and %v out of order", n.key, next.key)
+		}
+	}
+	return nil
+}
+
+// validate checks the subtree at n and returns its black height.
+func (t *Tree[K, V]) validate(n *Node[K, V], count *int) (int, error) {
+	if n == nil {
+		return 1, nil
+	}
+	*count++
+	for _, child := range []*Node[K, V]{n.left, n.right} {
+		if child == nil {
+			continue
+		}
+		if child.parent != n {
+			return 0, fmt.Errorf("rbtree: node %v has a wrong parent link", child.key)
+		}
+		if n.color == red && child.color == red {
+			return 0, fmt.Errorf("rbtree: red node %v has a red child", n.key)
+		}
+	}
+	left, err := t.validate(n.left, count)
+	if err != nil {
+		return 0, err
+	}
+	right, err := t.validate(n.right, count)
+	if err != nil {
+		return 0, err
+	}
+	if left != right {
+		return 0, fmt.Errorf("rbtree: black heights differ below %v", n.key)
+	}
+	if n.color == black {
+		left++
+	}
+	return left, nil
+}
+
+// Iterator walks the nodes of a tree in ascending key order.
+type Iterator[K any, V any] struct {
+	next *Node[K, V]
+}
+
+// HasNext checks if there are more nodes.
+func (it *Iterator[K, V]) HasNext() bool {
+	return it.next != nil
+}
+
+// Next returns the next node. It panics if there are none left.
+func (it *Iterator[K, V]) Next() *Node[K, V] {
+	if it.next == nil {
+		panic("rbtree: iterator exhausted")
+	}
+	n := it.next
+	it.next = n.Next()
+	return n
+}
+
+// floor returns the greatest node with a key less than key, or equal to
+// it when inclusive is set.
+func (t *Tree[K, V]) floor(key K, inclusive bool) *Node[K, V] {
+	var best *Node[K, V]
+	n := t.root
+	for n != nil {
+		c := t.compare(key, n.key)
+		switch {
+		case c == 0 && inclusive:
+			return n
+		case c > 0:
+			best = n
+			n = n.right
+		default:
+			n = n.left
+		}
+	}
+	return best
+}
+
+// ceiling returns the least node with a key greater than key, or equal to
+// it when inclusive is set.
+func (t *Tree[K, V]) ceiling(key K, inclusive bool) *Node[K, V] {
+	var best *Node[K, V]
+	n := t.root
+	for n != nil {
+		c := t.compare(key, n.key)
+		switch {
+		case c == 0 && inclusive:
+			return n
+		case c < 0:
+			best = n
+			n = n.left
+		default:
+			n = n.right
+		}
+	}
+	return best
+}
+
+func minimum[K any, V any](n *Node[K, V]) *Node[K, V] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func maximum[K any, V any](n *Node[K, V]) *Node[K, V] {
+	for n.right != nil {
+		n = n.right
+	}
+	return n
+}
+
+func colorOf[K any, V any](n *Node[K, V]) color {
+	if n == nil {
+		return black
+	}
+	return n.color
+}
+
+func (t *Tree[K, V]) rotateLeft(x *Node[K, V]) {
+	y := x.right
+	x.right = y.left
+	if y.left != nil {
+		y.left.parent = x
+	}
+	t.transplant(x, y)
+	y.left = x
+	x.parent = y
+}
+
+func (t *Tree[K, V]) rotateRight(x *Node[K, V]) {
+	y := x.left
+	x.left = y.right
+	if y.right != nil {
+		y.right.parent = x
+	}
+	t.transplant(x, y)
+	y.right = x
+	x.parent = y
+}
+
+// transplant replaces the subtree rooted at u with the one rooted at v.
+func (t *Tree[K, V]) transplant(u, v *Node[K, V]) {
+	switch {
+	case u.parent == nil:
+		t.root = v
+	case u == u.parent.left:
+		u.parent.left = v
+	default:
+		u.parent.right = v
+	}
+	if v != nil {
+		v.parent = u.parent
+	}
+}
+
+func (t *Tree[K, V]) insertFixup(z *Node[K, V]) {
+	for colorOf(z.parent) == red {
+		grandparent := z.parent.parent
+		if z.parent == grandparent.left {
+			uncle := grandparent.right
+			if colorOf(uncle) == red {
+				z.parent.color = black
+				uncle.color = black
+				grandparent.color = red
+				z = grandparent
+				continue
+			}
+			if z == z.parent.right {
+				z = z.parent
+				t.rotateLeft(z)
+			}
+			z.parent.color = black
+			grandparent.color = red
+			t.rotateRight(grandparent)
+		} else {
+			uncle := grandparent.left
+			if colorOf(uncle) == red {
+				z.parent.color = black
+				uncle.color = black
+				grandparent.color = red
+				z = grandparent
+				continue
+			}
+			if z == z.parent.left {
+				z = z.parent
+				t.rotateRight(z)
+			}
+			z.parent.color = black
+			grandparent.color = red
+			t.rotateLeft(grandparent)
+		}
+	}
+	t.root.color = black
+}
+
+func (t *Tree[K, V]) deleteFixup(x, parent *Node[K, V]) {
+	for x != t.root && colorOf(x) == black {
+		if x == parent.left {
+			sibling := parent.right
+			if colorOf(sibling) == red {
+				sibling.color = black
+				parent.color = red
+				t.rotateLeft(parent)
+				sibling = parent.right
+			}
+			if colorOf(sibling.left) == black && colorOf(sibling.right) == black {
+				sibling.color = red
+				x, parent = parent, parent.parent
+				continue
+			}
+			if colorOf(sibling.right) == black {
+				sibling.left.color = black
+				sibling.color = red
+				t.rotateRight(sibling)
+				sibling = parent.right
+			}
+			sibling.color = parent.color
+			parent.color = black
+			sibling.right.color = black
+			t.rotateLeft(parent)
+		} else {
+			sibling := parent.left
+			if colorOf(sibling) == red {
+				sibling.color = black
+				parent.color = red
+				t.rotateRight(parent)
+				sibling = parent.left
+			}
+			if colorOf(sibling.left) == black && colorOf(sibling.right) == black {
+				sibling.color = red
+				x, parent = parent, parent.parent
+				continue
+			}
+			if colorOf(sibling.left) == black {
+				sibling.right.color = black
+				sibling.color = red
+				t.rotateLeft(sibling)
+				sibling = parent.left
+			}
+			sibling.color = parent.color
+			parent.color = black
+			sibling.left.color = black
+			t.rotateRight(parent)
+		}
+		x = t.root
+	}
+	if x != nil {
+		x.color = black
+	}
+}
@@ -0,0 +1,234 @@
+package rbtree_test
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/rbtree"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTree_BasicOperations(t *testing.T) {
+	tree := rbtree.NewOrdered[int, string]()
+	assert.True(t, tree.IsEmpty())
+	assert.Nil(t, tree.First())
+
+	assert.True(t, tree.Put(20, "b"))
+	assert.True(t, tree.Put(10, "a"))
+	assert.True(t, tree.Put(30, "c"))
+	assert.False(t, tree.Put(20, "B"))
+	assert.Equal(t, 3, tree.Len())
+
+	value, ok := tree.Get(20)
+	assert.True(t, ok)
+	assert.Equal(t, "B", value)
+	assert.True(t, tree.Contains(10))
+	assert.False(t, tree.Contains(15))
+	assert.Equal(t, []int{10, 20, 30}, tree.Keys())
+
+	assert.True(t, tree.Delete(20))
+	assert.False(t, tree.Delete(20))
+	assert.Equal(t, []int{10, 30}, tree.Keys())
+	assert.NoError(t, tree.Validate())
+
+	tree.Clear()
+	assert.Equal(t, 0, tree.Len())
+	assert.NoError(t, tree.Validate())
+}
+
+func TestTree_FloorAndCeiling(t *testing.T) {
+	tree := rbtree.NewOrdered[int, struct{}]()
+	for _, key := range []int{10, 20, 30, 40} {
+		tree.Put(key, struct{}{})
+	}
+
+	assert.Equal(t, 20, tree.Floor(20).Key())
+	assert.Equal(t, 20, tree.Floor(25).Key())
+	assert.Nil(t, tree.Floor(5))
+	assert.Equal(t, 10, tree.Lower(20).Key())
+	assert.Equal(t, 20, tree.Ceiling(20).Key())
+	assert.Equal(t, 30, tree.Ceiling(25).Key())
+	assert.Nil(t, tree.Ceiling(45))
+	assert.Equal(t, 30, tree.Higher(20).Key())
+	assert.Equal(t, 10, tree.First().Key())
+	assert.Equal(t, 40, tree.Last().Key())
+}
+
+func TestTree_IteratorAndHandles(t *testing.T) {
+	tree := rbtree.New[string, int](strings.Compare)
+	for i, key := range []string{"d", "b", "a", "c", "e"} {
+		tree.Put(key, i)
+	}
+
+	var keys []string
+	for it := tree.Iterator(); it.HasNext(); {
+		keys = append(keys, it.Next().Key())
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, keys)
+
+	keys = keys[:0]
+	for n := tree.Last(); n != nil; n = n.Prev() {
+		keys = append(keys, n.Key())
+	}
+	assert.Equal(t, []string{"e", "d", "c", "b", "a"}, keys)
+
+	// A node handle survives other updates and removes without a search
+	c := tree.Find("c")
+	for _, key := range []string{"f", "g", "h"} {
+		tree.Put(key, 0)
+	}
+	tree.Delete("b")
+	c.SetValue(42)
+	value, _ := tree.Get("c")
+	assert.Equal(t, 42, value)
+	tree.Remove(c)
+	assert.False(t, tree.Contains("c"))
+	assert.NoError(t, tree.Validate())
+
+	it := tree.Iterator()
+	for it.HasNext() {
+		it.Next()
+	}
+	assert.Panics(t, func() { it.Next() })
+}
+
+func TestTree_InsertFixupRecolorsAndRotates(t *testing.T) {
+	tree := rbtree.NewOrdered[int, int]()
+	steps := []struct {
+		key  int
+		want []string
+	}{
+		// A new root is painted black
+		{10, []string{"10"}},
+		{20, []string{"10", "20*"}},
+		// Red parent, black uncle on the outside: one rotation
+		{30, []string{"20", "10*", "30*"}},
+		// Red uncle: recolour and repaint the root black
+		{40, []string{"20", "10", "30", "40*"}},
+		// Red parent, black uncle on the inside: two rotations
+		{35, []string{"20", "10", "35", "30*", "40*"}},
+		// Recolouring moves the red up to 35 below a black parent
+		{45, []string{"20", "10", "35*", "30", "40", "45*"}},
+	}
+	for _, step := range steps {
+		tree.Put(step.key, step.key)
+		assert.Equal(t, step.want, tree.Preorder(), "after inserting %d", step.key)
+		assert.NoError(t, tree.Validate())
+	}
+}
+
+func TestTree_DeleteFixupKeepsBlackHeight(t *testing.T) {
+	tree := rbtree.NewOrdered[int, int]()
+	for i := 1; i <= 10; i++ {
+		tree.Put(10*i, i)
+	}
+	assert.Equal(t, []string{"40", "20", "10", "30", "60", "50", "80*", "70", "90", "100*"}, tree.Preorder())
+	assert.Equal(t, 3, tree.BlackHeight())
+
+	steps := []struct {
+		key         int
+		want        []string
+		blackHeight int
+	}{
+		// A black leaf with a black sibling pushes the deficit up, then a
+		// red far nephew on the other side absorbs it with a rotation
+		{10, []string{"60", "40", "20", "30*", "50", "80", "70", "90", "100*"}, 3},
+		// Red leaves go without a fix-up
+		{30, []string{"60", "40", "20", "50", "80", "70", "90", "100*"}, 3},
+		{100, []string{"60", "40", "20", "50", "80", "70", "90"}, 3},
+		// Black siblings all the way up: recolouring reaches the root and
+		// the black height shrinks
+		{80, []string{"60", "40*", "20", "50", "90", "70*"}, 2},
+		// The deficit stops at a red node, which turns black
+		{40, []string{"60", "50", "20*", "90", "70*"}, 2},
+	}
+	for _, step := range steps {
+		tree.Delete(step.key)
+		assert.Equal(t, step.want, tree.Preorder(), "after deleting %d", step.key)
+		assert.Equal(t, step.blackHeight, tree.BlackHeight())
+		assert.NoError(t, tree.Validate())
+	}
+
+	// A red sibling is rotated above the parent before recolouring
+	tree = rbtree.NewOrdered[int, int]()
+	for _, key := range []int{20, 10, 30, 40, 50, 60} {
+		tree.Put(key, key)
+	}
+	assert.Equal(t, []string{"20", "10", "40*", "30", "50", "60*"}, tree.Preorder())
+	tree.Delete(10)
+	assert.Equal(t, []string{"40", "20", "30*", "50", "60*"}, tree.Preorder())
+	assert.NoError(t, tree.Validate())
+
+	// A red near nephew is rotated outside first
+	tree = rbtree.NewOrdered[int, int]()
+	for _, key := range []int{20, 10, 30, 25} {
+		tree.Put(key, key)
+	}
+	tree.Delete(10)
+	assert.Equal(t, []string{"25", "20", "30"}, tree.Preorder())
+	assert.NoError(t, tree.Validate())
+}
+
+func TestTree_RootStaysBlack(t *testing.T) {
+	tree := rbtree.NewOrdered[int, int]()
+	tree.Put(10, 10)
+	tree.Put(20, 20)
+	// The red child that replaces a deleted root is painted black
+	tree.Delete(10)
+	assert.Equal(t, []string{"20"}, tree.Preorder())
+	tree.Remove(tree.First())
+	assert.True(t, tree.IsEmpty())
+	assert.NoError(t, tree.Validate())
+
+	for i := 0; i < 100; i++ {
+		tree.Put(i, i)
+		assert.NotContains(t, tree.Preorder()[0], "*")
+	}
+	for i := 0; i < 100; i += 3 {
+		tree.Delete(i)
+		assert.NotContains(t, tree.Preorder()[0], "*")
+	}
+	assert.NoError(t, tree.Validate())
+}
+
+func TestTree_RandomOperationsKeepInvariants(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	tree := rbtree.NewOrdered[int, int]()
+	reference := map[int]int{}
+
+	for i := 0; i < 5000; i++ {
+		key := rng.Intn(500)
+		if rng.Intn(3) == 0 {
+			_, exists := reference[key]
+			assert.Equal(t, exists, tree.Delete(key))
+			delete(reference, key)
+		} else {
+			_, exists := reference[key]
+			assert.Equal(t, !exists, tree.Put(key, i))
+			reference[key] = i
+		}
+		if i%100 == 0 {
+			if err := tree.Validate(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	assert.NoError(t, tree.Validate())
+	// A red-black tree is at most twice as deep as a perfect one
+	assert.LessOrEqual(t, float64(tree.Height()), 2*math.Log2(float64(tree.Len()+1)))
+	assert.GreaterOrEqual(t, 2*tree.BlackHeight(), tree.Height())
+
+	keys := make([]int, 0, len(reference))
+	for key := range reference {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	assert.Equal(t, keys, tree.Keys())
+	tree.Each(func(key, value int) bool {
+		assert.Equal(t, reference[key], value)
+		return true
+	})
+}
@@ -0,0 +1,188 @@
+package segmenttree
+
+import (
+	"fmt"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/monoid"
+)
+
+// dnode is a node of a dynamic segment tree. Its aggregate includes its
+// pending update; the children do not yet.
+type dnode[T any, U any] struct {
+	aggregate T
+	pending   U
+	updated   bool
+	left      *dnode[T, U]
+	right     *dnode[T, U]
+}
+
+// Dynamic is a segment tree over a huge coordinate range of up to
+// math.MaxInt64 positions, such as [math.MinInt64/2, math.MaxInt64/2).
+// Nodes are created only where values are set or updated, so memory grows
+// with the number of operations rather than with the range. Every
+// position starts as the identity of the monoid.
+type Dynamic[T any, U any] struct {
+	from, to int64
+	root     *dnode[T, U]
+	nodes    int
+	monoid   monoid.Monoid[T]
+	action   monoid.Action[T, U]
+}
+
+// NewDynamic creates a Dynamic tree over the positions in [from, to).
+// It panics if the range is empty or holds more than math.MaxInt64
+// positions.
+func NewDynamic[T any, U any](from, to int64, m monoid.Monoid[T], action monoid.Action[T, U]) *Dynamic[T, U] {
+	if from >= to || to-from <= 0 {
+		panic(fmt.Sprintf("segmenttree: invalid range [%d, %d)", from, to))
+	}
+	d := &Dynamic[T, U]{from: from, to: to, monoid: m, action: action}
+	d.root = d.newNode()
+	return d
+}
+
+// Bounds returns the range of positions of the tree.
+func (d *Dynamic[T, U]) Bounds() (from, to int64) {
+	return d.from, d.to
+}
+
+// Nodes returns the number of nodes allocated so far.
+func (d *Dynamic[T, U]) Nodes() int {
+	return d.nodes
+}
+
+// Get returns the value at position i. It panics if i is out of range.
+func (d *Dynamic[T, U]) Get(i int64) T {
+	d.checkIndex(i)
+	return d.Query(i, i+1)
+}
+
+// Set replaces the value at position i. It panics if i is out of range.
+func (d *Dynamic[T, U]) Set(i int64, value T) {
+	d.checkIndex(i)
+	d.set(d.root, d.from, d.to, i, value)
+}
+
+// Query returns the aggregate of the values in [from, to). It panics if
+// the range is invalid.
+func (d *Dynamic[T, U]) Query(from, to int64) T {
+	d.checkRange(from, to)
+	if from == to {
+		return d.monoid.Identity
+	}
+	return d.query(d.root, d.from, d.to, from, to)
+}
+
+// Update applies u to every value in [from, to). It panics if the range
+// is invalid.
+func (d *Dynamic[T, U]) Update(from, to int64, u U) {
+	d.checkRange(from, to)
+	if from < to {
+		d.update(d.root, d.from, d.to, from, to, u)
+	}
+}
+
+func (d *Dynamic[T, U]) checkIndex(i int64) {
+	if i < d.from || i >= d.to {
+		panic(fmt.Sprintf("segmenttree: index %d out of range [%d, %d)", i, d.from, d.to))
+	}
+}
+
+func (d *Dynamic[T, U]) checkRange(from, to int64) {
+	if from < d.from || to > d.to || from > to {
+		panic(fmt.Sprintf("segmenttree: invalid range [%d, %d) in [%d, %d)", from, to, d.from, d.to))
+	}
+}
+
+func (d *Dynamic[T, U]) newNode() *dnode[T, U] {
+	d.nodes++
+	return &dnode[T, U]{aggregate: d.monoid.Identity}
+}
+
+func middle(lo, hi int64) int64 {
+	return lo + (hi-lo)/2
+}
+
+// apply updates the subtree at n, covering the positions in [lo, hi), and
+// defers the update for its children.
+func (d *Dynamic[T, U]) apply(n *dnode[T, U], lo, hi int64, u U) {
+	n.aggregate = d.action.Apply(u, n.aggregate, int(hi-lo))
+	if hi-lo > 1 {
+		if n.updated {
+			n.pending = d.action.Compose(u, n.pending)
+		} else {
+			n.pending, n.updated = u, true
+		}
+	}
+}
+
+// push creates the children of n if needed and hands its deferred update
+// down to them.
+func (d *Dynamic[T, U]) push(n *dnode[T, U], lo, hi int64) {
+	if n.left == nil {
+		n.left, n.right = d.newNode(), d.newNode()
+	}
+	if n.updated {
+		mid := middle(lo, hi)
+		d.apply(n.left, lo, mid, n.pending)
+		d.apply(n.right, mid, hi, n.pending)
+		var zero U
+		n.pending, n.updated = zero, false
+	}
+}
+
+func (d *Dynamic[T, U]) pull(n *dnode[T, U]) {
+	n.aggregate = d.monoid.Combine(n.left.aggregate, n.right.aggregate)
+}
+
+func (d *Dynamic[T, U]) query(n *dnode[T, U], lo, hi, from, to int64) T {
+	if from <= lo && hi <= to {
+		return n.aggregate
+	}
+	// Without children every position below n holds the identity with the
+	// pending update applied, which is newer than anything below n
+	result := d.monoid.Identity
+	if n.left != nil {
+		mid := middle(lo, hi)
+		if from < mid {
+			result = d.query(n.left, lo, mid, from, min(to, mid))
+		}
+		if to > mid {
+			result = d.monoid.Combine(result, d.query(n.right, mid, hi, max(from, mid), to))
+		}
+	}
+	if n.updated {
+		result = d.action.Apply(n.pending, result, int(min(to, hi)-max(from, lo)))
+	}
+	return result
+}
+
+func (d *Dynamic[T, U]) set(n *dnode[T, U], lo, hi, i int64, value T) {
+	if hi-lo == 1 {
+		n.aggregate = value
+		return
+	}
+	d.push(n, lo, hi)
+	if mid := middle(lo, hi); i < mid {
+		d.set(n.left, lo, mid, i, value)
+	} else {
+		d.set(n.right, mid, hi, i, value)
+	}
+	d.pull(n)
+}
+
+func (d *Dynamic[T, U]) update(n *dnode[T, U], lo, hi, from, to int64, u U) {
+	if from <= lo && hi <= to {
+		d.apply(n, lo, hi, u)
+		return
+	}
+	d.push(n, lo, hi)
+	mid := middle(lo, hi)
+	if from < mid {
+		d.update(n.left, lo, mid, from, to, u)
+	}
+	if to > mid {
+		d.update(n.right, mid, hi, from, to, u)
+	}
+	d.pull(n)
+}
@@ -0,0 +1,211 @@
+package segmenttree
+
+import (
+	"fmt"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/monoid"
+)
+
+// pnode is an immutable node of a persistent segment tree. Its aggregate
+// includes its pending update; the children do not yet.
+type pnode[T any, U any] struct {
+	aggregate T
+	pending   U
+	updated   bool
+	left      *pnode[T, U]
+	right     *pnode[T, U]
+}
+
+// Persistent is a segment tree that keeps every version. Each update
+// copies only the O(log n) nodes on its paths and returns a new version;
+// every earlier version stays available for queries and further updates.
+type Persistent[T any, U any] struct {
+	n      int
+	roots  []*pnode[T, U]
+	monoid monoid.Monoid[T]
+	action monoid.Action[T, U]
+}
+
+// NewPersistent creates a Persistent tree whose version 0 holds values.
+func NewPersistent[T any, U any](values []T, m monoid.Monoid[T], action monoid.Action[T, U]) *Persistent[T, U] {
+	p := &Persistent[T, U]{n: len(values), monoid: m, action: action}
+	p.roots = append(p.roots, p.build(values))
+	return p
+}
+
+// Len returns the number of values in every version.
+func (p *Persistent[T, U]) Len() int {
+	return p.n
+}
+
+// Versions returns the number of versions. They are numbered from 0.
+func (p *Persistent[T, U]) Versions() int {
+	return len(p.roots)
+}
+
+// Get returns the value at index i in version. It panics if the version
+// or i is out of range.
+func (p *Persistent[T, U]) Get(version, i int) T {
+	if i < 0 || i >= p.n {
+		panic(fmt.Sprintf("segmenttree: index %d out of range with size %d", i, p.n))
+	}
+	return p.Query(version, i, i+1)
+}
+
+// Query returns the aggregate of the values in [from, to) in version.
+// It panics if the version or the range is invalid.
+func (p *Persistent[T, U]) Query(version, from, to int) T {
+	root := p.root(version)
+	p.checkRange(from, to)
+	if from == to {
+		return p.monoid.Identity
+	}
+	return p.query(root, 0, p.n, from, to)
+}
+
+// Set creates a version equal to version but with the value at index i
+// replaced, and returns its number. It panics if the version or i is out
+// of range.
+func (p *Persistent[T, U]) Set(version, i int, value T) int {
+	root := p.root(version)
+	if i < 0 || i >= p.n {
+		panic(fmt.Sprintf("segmenttree: index %d out of range with size %d", i, p.n))
+	}
+	p.roots = append(p.roots, p.set(root, 0, p.n, i, value))
+	return len(p.roots) - 1
+}
+
+// Update creates a version equal to version but with u applied to every
+// value in [from, to), and returns its number. It panics if the version
+// or the range is invalid.
+func (p *Persistent[T, U]) Update(version, from, to int, u U) int {
+	root := p.root(version)
+	p.checkRange(from, to)
+	if from < to {
+		root = p.update(root, 0, p.n, from, to, u)
+	}
+	p.roots = append(p.roots, root)
+	return len(p.roots) - 1
+}
+
+// Values returns the values of version in order.
+func (p *Persistent[T, U]) Values(version int) []T {
+	root := p.root(version)
+	values := make([]T, 0, p.n)
+	var walk func(n *pnode[T, U], lo, hi int)
+	walk = func(n *pnode[T, U], lo, hi int) {
+		if hi-lo == 1 {
+			values = append(values, n.aggregate)
+			return
+		}
+		left, right := p.children(n, lo, hi)
+		mid := lo + (hi-lo)/2
+		walk(left, lo, mid)
+		walk(right, mid, hi)
+	}
+	if p.n > 0 {
+		walk(root, 0, p.n)
+	}
+	return values
+}
+
+func (p *Persistent[T, U]) root(version int) *pnode[T, U] {
+	if version < 0 || version >= len(p.roots) {
+		panic(fmt.Sprintf("segmenttree: version %d out of range with %d versions", version, len(p.roots)))
+	}
+	return p.roots[version]
+}
+
+func (p *Persistent[T, U]) checkRange(from, to int) {
+	if from < 0 || to > p.n || from > to {
+		panic(fmt.Sprintf("segmenttree: invalid range [%d, %d) with size %d", from, to, p.n))
+	}
+}
+
+func (p *Persistent[T, U]) build(values []T) *pnode[T, U] {
+	if len(values) == 0 {
+		return nil
+	}
+	if len(values) == 1 {
+		return &pnode[T, U]{aggregate: values[0]}
+	}
+	mid := len(values) / 2
+	return p.join(p.build(values[:mid]), p.build(values[mid:]))
+}
+
+func (p *Persistent[T, U]) join(left, right *pnode[T, U]) *pnode[T, U] {
+	return &pnode[T, U]{aggregate: p.monoid.Combine(left.aggregate, right.aggregate), left: left, right: right}
+}
+
+// applied returns a copy of the subtree at n, covering hi-lo values,
+// with u applied.
+func (p *Persistent[T, U]) applied(n *pnode[T, U], lo, hi int, u U) *pnode[T, U] {
+	c := *n
+	c.aggregate = p.action.Apply(u, n.aggregate, hi-lo)
+	if hi-lo > 1 {
+		if c.updated {
+			c.pending = p.action.Compose(u, c.pending)
+		} else {
+			c.pending, c.updated = u, true
+		}
+	}
+	return &c
+}
+
+// children returns the children of n with its pending update applied,
+// copying them if needed so that n itself is left unchanged.
+func (p *Persistent[T, U]) children(n *pnode[T, U], lo, hi int) (*pnode[T, U], *pnode[T, U]) {
+	if !n.updated {
+		return n.left, n.right
+	}
+	mid := lo + (hi-lo)/2
+	return p.applied(n.left, lo, mid, n.pending), p.applied(n.right, mid, hi, n.pending)
+}
+
+func (p *Persistent[T, U]) query(n *pnode[T, U], lo, hi, from, to int) T {
+	if from <= lo && hi <= to {
+		return n.aggregate
+	}
+	mid := lo + (hi-lo)/2
+	result := p.monoid.Identity
+	if from < mid {
+		result = p.query(n.left, lo, mid, from, min(to, mid))
+	}
+	if to > mid {
+		result = p.monoid.Combine(result, p.query(n.right, mid, hi, max(from, mid), to))
+	}
+	// The pending update of n is newer than anything below it
+	if n.updated {
+		result = p.action.Apply(n.pending, result, min(to, hi)-max(from, lo))
+	}
+	return result
+}
+
+func (p *Persistent[T, U]) set(n *pnode[T, U], lo, hi, i int, value T) *pnode[T, U] {
+	if hi-lo == 1 {
+		return &pnode[T, U]{aggregate: value}
+	}
+	left, right := p.children(n, lo, hi)
+	mid := lo + (hi-lo)/2
+	if i < mid {
+		left = p.set(left, lo, mid, i, value)
+	} else {
+		right = p.set(right, mid, hi, i, value)
+	}
+	return p.join(left, right)
+}
+
+func (p *Persistent[T, U]) update(n *pnode[T, U], lo, hi, from, to int, u U) *pnode[T, U] {
+	if from <= lo && hi <= to {
+		return p.applied(n, lo, hi, u)
+	}
+	left, right := p.children(n, lo, hi)
+	mid := lo + (hi-lo)/2
+	if from < mid {
+		left = p.update(left, lo, mid, from, to, u)
+	}
+	if to > mid {
+		right = p.update(right, mid, hi, from, to, u)
+	}
+	return p.join(left, right)
+}
@@ -0,0 +1,253 @@
+package segmenttree
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/monoid"
+)
+
+// Tree is a segment tree over a fixed number of values. It answers the
+// aggregate of any range under a monoid and applies an update to a whole
+// range in O(log n), deferring the work below fully covered nodes.
+//
+// The tree is stored implicitly in arrays: node k has children 2k and
+// 2k+1 and the leaves start at index size.
+type Tree[T any, U any] struct {
+	n       int
+	size    int
+	log     int
+	data    []T
+	pending []U
+	updated []bool
+	monoid  monoid.Monoid[T]
+	action  monoid.Action[T, U]
+}
+
+// New creates a Tree of n values, all equal to the identity of m.
+// Range updates are applied with action, which may be left empty if
+// Update is never called. It panics if n is negative.
+func New[T any, U any](n int, m monoid.Monoid[T], action monoid.Action[T, U]) *Tree[T, U] {
+	if n < 0 {
+		panic(fmt.Sprintf("segmenttree: invalid size %d", n))
+	}
+	log := bits.Len(uint(max(n-1, 0)))
+	size := 1 << log
+	t := &Tree[T, U]{
+		n:       n,
+		size:    size,
+		log:     log,
+		data:    make([]T, 2*size),
+		pending: make([]U, size),
+		updated: make([]bool, size),
+		monoid:  m,
+		action:  action,
+	}
+	for i := range t.data {
+		t.data[i] = m.Identity
+	}
+	return t
+}
+
+// NewFrom creates a Tree holding values, built in O(n).
+func NewFrom[T any, U any](values []T, m monoid.Monoid[T], action monoid.Action[T, U]) *Tree[T, U] {
+	t := New(len(values), m, action)
+	copy(t.data[t.size:], values)
+	for k := t.size - 1; k >= 1; k-- {
+		t.pull(k)
+	}
+	return t
+}
+
+// Len returns the number of values.
+func (t *Tree[T, U]) Len() int {
+	return t.n
+}
+
+// Get returns the value at index i. It panics if i is out of range.
+func (t *Tree[T, U]) Get(i int) T {
+	t.checkIndex(i)
+	i += t.size
+	for level := t.log; level >= 1; level-- {
+		t.push(i >> level)
+	}
+	return t.data[i]
+}
+
+// Set replaces the value at index i. It panics if i is out of range.
+func (t *Tree[T, U]) Set(i int, value T) {
+	t.checkIndex(i)
+	i += t.size
+	for level := t.log; level >= 1; level-- {
+		t.push(i >> level)
+	}
+	t.data[i] = value
+	for level := 1; level <= t.log; level++ {
+		t.pull(i >> level)
+	}
+}
+
+// Query returns the aggregate of the values in [from, to). It panics if
+// the range is invalid.
+func (t *Tree[T, U]) Query(from, to int) T {
+	t.checkRange(from, to)
+	if from == to {
+		return t.monoid.Identity
+	}
+	from += t.size
+	to += t.size
+	t.pushBounds(from, to)
+	left, right := t.monoid.Identity, t.monoid.Identity
+	for from < to {
+		if from&1 == 1 {
+			left = t.monoid.Combine(left, t.data[from])
+			from++
+		}
+		if to&1 == 1 {
+			to--
+			right = t.monoid.Combine(t.data[to], right)
+		}
+		from >>= 1
+		to >>= 1
+	}
+	return t.monoid.Combine(left, right)
+}
+
+// All returns the aggregate of every value in O(1).
+func (t *Tree[T, U]) All() T {
+	return t.data[1]
+}
+
+// Update applies u to every value in [from, to). It panics if the range
+// is invalid.
+func (t *Tree[T, U]) Update(from, to int, u U) {
+	t.checkRange(from, to)
+	if from == to {
+		return
+	}
+	from += t.size
+	to += t.size
+	t.pushBounds(from, to)
+	for l, r := from, to; l < r; l, r = l>>1, r>>1 {
+		if l&1 == 1 {
+			t.apply(l, u)
+			l++
+		}
+		if r&1 == 1 {
+			r--
+			t.apply(r, u)
+		}
+	}
+	for level := 1; level <= t.log; level++ {
+		if (from>>level)<<level != from {
+			t.pull(from >> level)
+		}
+		if (to>>level)<<level != to {
+			t.pull((to - 1) >> level)
+		}
+	}
+}
+
+// MaxRight returns the largest to such that pred holds for the aggregate
+// of [from, to), assuming pred holds for the identity and, once false,
+// stays false as the range grows. It panics if from is out of range.
+func (t *Tree[T, U]) MaxRight(from int, pred func(aggregate T) bool) int {
+	if from < 0 || from > t.n {
+		panic(fmt.Sprintf("segmenttree: index %d out of range with size %d", from, t.n))
+	}
+	if from == t.n {
+		return t.n
+	}
+	from += t.size
+	for level := t.log; level >= 1; level-- {
+		t.push(from >> level)
+	}
+	acc := t.monoid.Identity
+	for {
+		for from&1 == 0 {
+			from >>= 1
+		}
+		if !pred(t.monoid.Combine(acc, t.data[from])) {
+			// Descend to the first leaf that makes pred fail
+			for from < t.size {
+				t.push(from)
+				from <<= 1
+				if next := t.monoid.Combine(acc, t.data[from]); pred(next) {
+					acc = next
+					from++
+				}
+			}
+			return from - t.size
+		}
+		acc = t.monoid.Combine(acc, t.data[from])
+		from++
+		if from&-from == from {
+			return t.n
+		}
+	}
+}
+
+// Values returns the values in order.
+func (t *Tree[T, U]) Values() []T {
+	for k := 1; k < t.size; k++ {
+		t.push(k)
+	}
+	return append([]T(nil), t.data[t.size:t.size+t.n]...)
+}
+
+func (t *Tree[T, U]) checkIndex(i int) {
+	if i < 0 || i >= t.n {
+		panic(fmt.Sprintf("segmenttree: index %d out of range with size %d", i, t.n))
+	}
+}
+
+func (t *Tree[T, U]) checkRange(from, to int) {
+	if from < 0 || to > t.n || from > to {
+		panic(fmt.Sprintf("segmenttree: invalid range [%d, %d) with size %d", from, to, t.n))
+	}
+}
+
+// pushBounds pushes the deferred updates on the paths to the leaves just
+// inside [from, to), which are given as leaf indexes.
+func (t *Tree[T, U]) pushBounds(from, to int) {
+	for level := t.log; level >= 1; level-- {
+		if (from>>level)<<level != from {
+			t.push(from >> level)
+		}
+		if (to>>level)<<level != to {
+			t.push((to - 1) >> level)
+		}
+	}
+}
+
+// width returns the number of leaves below node k.
+func (t *Tree[T, U]) width(k int) int {
+	return t.size >> (bits.Len(uint(k)) - 1)
+}
+
+// apply updates node k and defers the update for its children.
+func (t *Tree[T, U]) apply(k int, u U) {
+	t.data[k] = t.action.Apply(u, t.data[k], t.width(k))
+	if k < t.size {
+		if t.updated[k] {
+			t.pending[k] = t.action.Compose(u, t.pending[k])
+		} else {
+			t.pending[k], t.updated[k] = u, true
+		}
+	}
+}
+
+// push hands the deferred update of node k down to its children.
+func (t *Tree[T, U]) push(k int) {
+	if !t.updated[k] {
+		return
+	}
+	t.apply(2*k, t.pending[k])
+	t.apply(2*k+1, t.pending[k])
+	var zero U
+	t.pending[k], t.updated[k] = zero, false
+}
+
+func (t *Tree[T, U]) pull(k int) {
+	t.data[k] = t.monoid.Combine(t.data[2*k], t.data[2*k+1])
+}
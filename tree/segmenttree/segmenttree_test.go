@@ -0,0 +1,202 @@
+package segmenttree_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/monoid"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/segmenttree"
+	"github.com/stretchr/testify/assert"
+)
+
+func sum(values []int) int {
+	total := 0
+	for _, value := range values {
+		total += value
+	}
+	return total
+}
+
+func TestTree_SumWithRangeAdd(t *testing.T) {
+	tree := segmenttree.NewFrom([]int{5, 3, 8, 1, 9, 2}, monoid.Sum[int](), monoid.AddToSum[int]())
+	assert.Equal(t, 6, tree.Len())
+	assert.Equal(t, 28, tree.All())
+	assert.Equal(t, 12, tree.Query(1, 4))
+	assert.Equal(t, 0, tree.Query(3, 3))
+
+	tree.Update(2, 5, 10)
+	assert.Equal(t, []int{5, 3, 18, 11, 19, 2}, tree.Values())
+	assert.Equal(t, 48, tree.Query(2, 5))
+
+	tree.Set(3, 0)
+	assert.Equal(t, 0, tree.Get(3))
+	assert.Equal(t, 47, tree.All())
+
+	assert.Panics(t, func() { tree.Get(6) })
+	assert.Panics(t, func() { tree.Query(4, 2) })
+	assert.Panics(t, func() { segmenttree.New(-1, monoid.Sum[int](), monoid.AddToSum[int]()) })
+}
+
+func TestTree_MaxRight(t *testing.T) {
+	tree := segmenttree.NewFrom([]int{2, 1, 3, 4, 1, 5}, monoid.Sum[int](), monoid.Action[int, int]{})
+	atMost := func(limit int) func(int) bool {
+		return func(aggregate int) bool { return aggregate <= limit }
+	}
+	assert.Equal(t, 0, tree.MaxRight(0, atMost(1)))
+	assert.Equal(t, 3, tree.MaxRight(0, atMost(6)))
+	assert.Equal(t, 6, tree.MaxRight(0, atMost(100)))
+	assert.Equal(t, 4, tree.MaxRight(2, atMost(7)))
+	assert.Equal(t, 6, tree.MaxRight(6, atMost(0)))
+}
+
+func TestTree_MatchesSlice(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 7, 64, 100} {
+		sums := segmenttree.New(n, monoid.Sum[int](), monoid.AddToSum[int]())
+		mins := segmenttree.New(n, monoid.Min(math.MaxInt), monoid.AssignToExtreme[int]())
+		model := make([]int, n)
+		mins.Update(0, n, 0)
+
+		for i := 0; i < 2000; i++ {
+			from := rng.Intn(n + 1)
+			to := from + rng.Intn(n-from+1)
+			switch rng.Intn(4) {
+			case 0:
+				if from < n {
+					value := rng.Intn(100)
+					sums.Set(from, value)
+					mins.Set(from, value)
+					model[from] = value
+				}
+			case 1:
+				// Keep both trees in step by assigning to mins what sums
+				// ends up holding
+				delta := rng.Intn(21) - 10
+				sums.Update(from, to, delta)
+				for j := from; j < to; j++ {
+					model[j] += delta
+					mins.Set(j, model[j])
+				}
+			case 2:
+				value := rng.Intn(100)
+				mins.Update(from, to, value)
+				for j := from; j < to; j++ {
+					sums.Set(j, value)
+					model[j] = value
+				}
+			default:
+				assert.Equal(t, sum(model[from:to]), sums.Query(from, to))
+				low := math.MaxInt
+				for _, value := range model[from:to] {
+					low = min(low, value)
+				}
+				assert.Equal(t, low, mins.Query(from, to))
+			}
+		}
+		assert.Equal(t, model, sums.Values())
+		assert.Equal(t, model, mins.Values())
+	}
+}
+
+func TestPersistent_Versions(t *testing.T) {
+	p := segmenttree.NewPersistent([]int{1, 2, 3, 4}, monoid.Sum[int](), monoid.AddToSum[int]())
+	v1 := p.Update(0, 1, 3, 10)
+	v2 := p.Set(v1, 0, 100)
+	v3 := p.Update(0, 0, 4, 1)
+
+	assert.Equal(t, 4, p.Versions())
+	assert.Equal(t, []int{1, 2, 3, 4}, p.Values(0))
+	assert.Equal(t, []int{1, 12, 13, 4}, p.Values(v1))
+	assert.Equal(t, []int{100, 12, 13, 4}, p.Values(v2))
+	assert.Equal(t, []int{2, 3, 4, 5}, p.Values(v3))
+	assert.Equal(t, 25, p.Query(v1, 1, 3))
+	assert.Equal(t, 10, p.Query(0, 0, 4))
+	assert.Equal(t, 12, p.Get(v2, 1))
+
+	assert.Panics(t, func() { p.Query(4, 0, 1) })
+	assert.Panics(t, func() { p.Get(0, 4) })
+}
+
+func TestPersistent_MatchesSlices(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	const n = 37
+	p := segmenttree.NewPersistent(make([]int, n), monoid.Sum[int](), monoid.AssignToSum[int]())
+	models := [][]int{make([]int, n)}
+
+	for i := 0; i < 1000; i++ {
+		version := rng.Intn(len(models))
+		from := rng.Intn(n + 1)
+		to := from + rng.Intn(n-from+1)
+		model := append([]int(nil), models[version]...)
+		if rng.Intn(2) == 0 && from < n {
+			value := rng.Intn(100)
+			assert.Equal(t, len(models), p.Set(version, from, value))
+			model[from] = value
+		} else {
+			value := rng.Intn(100)
+			assert.Equal(t, len(models), p.Update(version, from, to, value))
+			for j := from; j < to; j++ {
+				model[j] = value
+			}
+		}
+		models = append(models, model)
+
+		check := rng.Intn(len(models))
+		from = rng.Intn(n + 1)
+		to = from + rng.Intn(n-from+1)
+		assert.Equal(t, sum(models[check][from:to]), p.Query(check, from, to))
+	}
+	for version, model := range models {
+		assert.Equal(t, model, p.Values(version))
+	}
+}
+
+func TestDynamic_HugeRange(t *testing.T) {
+	d := segmenttree.NewDynamic(math.MinInt64/2, math.MaxInt64/2, monoid.Sum[int](), monoid.AddToSum[int]())
+	d.Update(-1_000_000_000_000, 1_000_000_000_000, 1)
+	d.Set(5, 100)
+	d.Update(0, 10, 2)
+
+	assert.Equal(t, 2_000_000_000_000+99+20, d.Query(math.MinInt64/2, math.MaxInt64/2))
+	assert.Equal(t, 102, d.Get(5))
+	assert.Equal(t, 3, d.Get(9))
+	assert.Equal(t, 1, d.Get(-1_000_000_000_000))
+	assert.Equal(t, 0, d.Get(1_000_000_000_000))
+	assert.Less(t, d.Nodes(), 1000)
+
+	assert.Panics(t, func() { d.Get(math.MaxInt64 / 2) })
+	assert.Panics(t, func() { segmenttree.NewDynamic(0, 0, monoid.Sum[int](), monoid.AddToSum[int]()) })
+	assert.Panics(t, func() {
+		segmenttree.NewDynamic(math.MinInt64, math.MaxInt64, monoid.Sum[int](), monoid.AddToSum[int]())
+	})
+}
+
+func TestDynamic_MatchesSlice(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	const base, n = int64(1) << 50, 200
+	d := segmenttree.NewDynamic(-base, base, monoid.Sum[int](), monoid.AddToSum[int]())
+	model := make([]int, n)
+
+	for i := 0; i < 2000; i++ {
+		from := rng.Intn(n + 1)
+		to := from + rng.Intn(n-from+1)
+		switch rng.Intn(3) {
+		case 0:
+			if from < n {
+				value := rng.Intn(100)
+				d.Set(int64(from)+base/3, value)
+				model[from] = value
+			}
+		case 1:
+			delta := rng.Intn(21) - 10
+			d.Update(int64(from)+base/3, int64(to)+base/3, delta)
+			for j := from; j < to; j++ {
+				model[j] += delta
+			}
+		default:
+			assert.Equal(t, sum(model[from:to]), d.Query(int64(from)+base/3, int64(to)+base/3))
+		}
+	}
+	assert.Equal(t, sum(model), d.Query(-base, base))
+}
@@ -0,0 +1,79 @@
+package sparsetable
+
+import (
+	"cmp"
+	"fmt"
+	"math/bits"
+)
+
+// Integer is the set of built-in integer types.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Table is a sparse table over a fixed slice of values. It precomputes
+// the aggregate of every range whose length is a power of two, in
+// O(n log n) time and space, and then answers any range query in O(1)
+// from two overlapping ranges.
+//
+// This only works for idempotent operations, where combining a value
+// with itself changes nothing, such as min, max, gcd, bitwise and/or.
+type Table[T any] struct {
+	levels [][]T
+	op     func(a, b T) T
+}
+
+// New creates a Table over values for op, which must be associative and
+// idempotent. The values are copied.
+func New[T any](values []T, op func(a, b T) T) *Table[T] {
+	t := &Table[T]{levels: [][]T{append([]T(nil), values...)}, op: op}
+	for width := 2; width <= len(values); width *= 2 {
+		prev := t.levels[len(t.levels)-1]
+		level := make([]T, len(values)-width+1)
+		for i := range level {
+			level[i] = op(prev[i], prev[i+width/2])
+		}
+		t.levels = append(t.levels, level)
+	}
+	return t
+}
+
+// NewMin creates a Table answering range minimums.
+func NewMin[T cmp.Ordered](values []T) *Table[T] {
+	return New(values, func(a, b T) T { return min(a, b) })
+}
+
+// NewMax creates a Table answering range maximums.
+func NewMax[T cmp.Ordered](values []T) *Table[T] {
+	return New(values, func(a, b T) T { return max(a, b) })
+}
+
+// NewGCD creates a Table answering the greatest common divisor of a range
+// of non-negative values.
+func NewGCD[T Integer](values []T) *Table[T] {
+	return New(values, gcd[T])
+}
+
+// Len returns the number of values.
+func (t *Table[T]) Len() int {
+	return len(t.levels[0])
+}
+
+// Query returns the aggregate of the values in [from, to). It panics if
+// the range is empty or out of bounds, since there is no identity to
+// return for an empty range.
+func (t *Table[T]) Query(from, to int) T {
+	if from < 0 || to > t.Len() || from >= to {
+		panic(fmt.Sprintf("sparsetable: invalid range [%d, %d) with size %d", from, to, t.Len()))
+	}
+	k := bits.Len(uint(to-from)) - 1
+	return t.op(t.levels[k][from], t.levels[k][to-1<<k])
+}
+
+func gcd[T Integer](a, b T) T {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
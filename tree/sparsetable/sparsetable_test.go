@@ -0,0 +1,52 @@
+package sparsetable_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/sparsetable"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable_Queries(t *testing.T) {
+	values := []int{5, 3, 8, 1, 9, 2, 7}
+	mins := sparsetable.NewMin(values)
+	maxs := sparsetable.NewMax(values)
+	assert.Equal(t, 7, mins.Len())
+	assert.Equal(t, 1, mins.Query(0, 7))
+	assert.Equal(t, 3, mins.Query(0, 3))
+	assert.Equal(t, 8, mins.Query(2, 3))
+	assert.Equal(t, 9, maxs.Query(1, 6))
+	assert.Equal(t, 7, maxs.Query(5, 7))
+
+	gcds := sparsetable.NewGCD([]uint{12, 18, 24, 7, 14})
+	assert.Equal(t, uint(6), gcds.Query(0, 3))
+	assert.Equal(t, uint(1), gcds.Query(0, 5))
+	assert.Equal(t, uint(7), gcds.Query(3, 5))
+
+	// The table keeps its own copy
+	values[3] = 100
+	assert.Equal(t, 1, mins.Query(0, 7))
+
+	assert.Panics(t, func() { mins.Query(3, 3) })
+	assert.Panics(t, func() { mins.Query(0, 8) })
+	assert.Panics(t, func() { sparsetable.NewMin([]int{}).Query(0, 0) })
+}
+
+func TestTable_MatchesScan(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 31, 32, 33, 200} {
+		values := make([]int, n)
+		for i := range values {
+			values[i] = rng.Intn(1000)
+		}
+		table := sparsetable.New(values, func(a, b int) int { return a | b })
+		for from := 0; from < n; from++ {
+			or := 0
+			for to := from + 1; to <= n; to++ {
+				or |= values[to-1]
+				assert.Equal(t, or, table.Query(from, to))
+			}
+		}
+	}
+}
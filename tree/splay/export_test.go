@@ -0,0 +1,17 @@
+package splay
+
+// Preorder returns the keys in preorder, which exposes the shape of the
+// tree and the key at its root to the tests.
+func (t *Tree[K, V]) Preorder() []K {
+	var keys []K
+	var walk func(n *node[K, V])
+	walk = func(n *node[K, V]) {
+		if n != nil {
+			keys = append(keys, n.key)
+			walk(n.left)
+			walk(n.right)
+		}
+	}
+	walk(t.root)
+	return keys
+}
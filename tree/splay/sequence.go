@@ -0,0 +1,391 @@
+package splay
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/monoid"
+)
+
+// seqNode is a node of an implicit splay tree. backward is the aggregate
+// of the subtree read right to left, which a reversal swaps with
+// aggregate. The value and both aggregates already include the pending
+// update and reversal; the children do not yet.
+type seqNode[T any, U any] struct {
+	value     T
+	aggregate T
+	backward  T
+	pending   U
+	updated   bool
+	reversed  bool
+	size      int
+	left      *seqNode[T, U]
+	right     *seqNode[T, U]
+	parent    *seqNode[T, U]
+}
+
+func seqSize[T any, U any](n *seqNode[T, U]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// Sequence is an implicit splay tree: the position of an element is its
+// key, so elements can be inserted, removed, cut out and joined anywhere
+// in O(log n) amortized. It keeps the aggregate of every subtree under a
+// monoid and applies range updates and reversals lazily. Every operation
+// restructures the tree, so a Sequence is not safe for concurrent reads.
+type Sequence[T any, U any] struct {
+	root   *seqNode[T, U]
+	monoid monoid.Monoid[T]
+	action monoid.Action[T, U]
+}
+
+// NewSequence creates an empty Sequence that aggregates values with m and
+// applies range updates with action.
+func NewSequence[T any, U any](m monoid.Monoid[T], action monoid.Action[T, U]) *Sequence[T, U] {
+	return &Sequence[T, U]{monoid: m, action: action}
+}
+
+// NewSequenceFrom creates a Sequence holding values in order, built as a
+// balanced tree in O(n).
+func NewSequenceFrom[T any, U any](values []T, m monoid.Monoid[T], action monoid.Action[T, U]) *Sequence[T, U] {
+	s := NewSequence(m, action)
+	s.root = s.build(values, nil)
+	return s
+}
+
+// Len returns the number of elements.
+func (s *Sequence[T, U]) Len() int {
+	return seqSize(s.root)
+}
+
+// Get returns the element at index i. It panics if i is out of range.
+func (s *Sequence[T, U]) Get(i int) T {
+	s.checkIndex(i, s.Len())
+	n := s.nodeAt(s.root, i)
+	s.splay(n)
+	s.root = n
+	return n.value
+}
+
+// Set replaces the element at index i. It panics if i is out of range.
+func (s *Sequence[T, U]) Set(i int, value T) {
+	s.checkIndex(i, s.Len())
+	n := s.nodeAt(s.root, i)
+	s.splay(n)
+	s.root = n
+	n.value = value
+	s.pull(n)
+}
+
+// Insert inserts value at index i, shifting later elements up. i may be
+// equal to Len to append. It panics if i is out of range.
+func (s *Sequence[T, U]) Insert(i int, value T) {
+	s.checkIndex(i, s.Len()+1)
+	left, right := s.split(s.root, i)
+	s.root = s.merge(s.merge(left, s.newNode(value)), right)
+}
+
+// Append adds value at the end.
+func (s *Sequence[T, U]) Append(value T) {
+	s.root = s.merge(s.root, s.newNode(value))
+}
+
+// Delete removes the element at index i and returns it. It panics if i is
+// out of range.
+func (s *Sequence[T, U]) Delete(i int) T {
+	s.checkIndex(i, s.Len())
+	n := s.nodeAt(s.root, i)
+	s.splay(n)
+	s.push(n)
+	left, right := n.left, n.right
+	if left != nil {
+		left.parent = nil
+	}
+	if right != nil {
+		right.parent = nil
+	}
+	s.root = s.merge(left, right)
+	return n.value
+}
+
+// Split moves the elements from index i on into a new Sequence and
+// returns it. It panics if i is out of range.
+func (s *Sequence[T, U]) Split(i int) *Sequence[T, U] {
+	s.checkIndex(i, s.Len()+1)
+	var right *seqNode[T, U]
+	s.root, right = s.split(s.root, i)
+	return &Sequence[T, U]{root: right, monoid: s.monoid, action: s.action}
+}
+
+// Concat appends every element of other, leaving other empty.
+func (s *Sequence[T, U]) Concat(other *Sequence[T, U]) {
+	s.root = s.merge(s.root, other.root)
+	other.root = nil
+}
+
+// Query returns the aggregate of the elements in [from, to). It panics if
+// the range is invalid.
+func (s *Sequence[T, U]) Query(from, to int) T {
+	result := s.monoid.Identity
+	s.apply(from, to, func(n *seqNode[T, U]) {
+		if n != nil {
+			result = n.aggregate
+		}
+	})
+	return result
+}
+
+// Update applies u to every element in [from, to). It panics if the
+// range is invalid.
+func (s *Sequence[T, U]) Update(from, to int, u U) {
+	s.apply(from, to, func(n *seqNode[T, U]) {
+		if n != nil {
+			s.update(n, u)
+		}
+	})
+}
+
+// Reverse reverses the order of the elements in [from, to). The monoid
+// need not be commutative: every node also keeps the aggregate of its
+// subtree in reverse order. It panics if the range is invalid.
+func (s *Sequence[T, U]) Reverse(from, to int) {
+	s.apply(from, to, func(n *seqNode[T, U]) {
+		if n != nil {
+			s.flip(n)
+		}
+	})
+}
+
+// Values returns the elements in order.
+func (s *Sequence[T, U]) Values() []T {
+	values := make([]T, 0, s.Len())
+	var walk func(n *seqNode[T, U])
+	walk = func(n *seqNode[T, U]) {
+		if n == nil {
+			return
+		}
+		s.push(n)
+		walk(n.left)
+		values = append(values, n.value)
+		walk(n.right)
+	}
+	walk(s.root)
+	return values
+}
+
+// Validate checks the parent links and the stored subtree sizes.
+// It is meant for tests.
+func (s *Sequence[T, U]) Validate() error {
+	if s.root != nil && s.root.parent != nil {
+		return errors.New("splay: root has a parent")
+	}
+	return validateSeq(s.root)
+}
+
+func validateSeq[T any, U any](n *seqNode[T, U]) error {
+	if n == nil {
+		return nil
+	}
+	for _, child := range []*seqNode[T, U]{n.left, n.right} {
+		if child != nil && child.parent != n {
+			return errors.New("splay: node has a wrong parent link")
+		}
+	}
+	if size := seqSize(n.left) + 1 + seqSize(n.right); size != n.size {
+		return fmt.Errorf("splay: node stores size %d, want %d", n.size, size)
+	}
+	if err := validateSeq(n.left); err != nil {
+		return err
+	}
+	return validateSeq(n.right)
+}
+
+func (s *Sequence[T, U]) newNode(value T) *seqNode[T, U] {
+	return &seqNode[T, U]{value: value, aggregate: value, backward: value, size: 1}
+}
+
+// build links values into a perfectly balanced subtree below parent.
+func (s *Sequence[T, U]) build(values []T, parent *seqNode[T, U]) *seqNode[T, U] {
+	if len(values) == 0 {
+		return nil
+	}
+	mid := len(values) / 2
+	n := s.newNode(values[mid])
+	n.parent = parent
+	n.left = s.build(values[:mid], n)
+	n.right = s.build(values[mid+1:], n)
+	s.pull(n)
+	return n
+}
+
+func (s *Sequence[T, U]) checkIndex(i, size int) {
+	if i < 0 || i >= size {
+		panic(fmt.Sprintf("splay: index %d out of range with size %d", i, s.Len()))
+	}
+}
+
+// apply cuts out [from, to), passes its root to fn and joins the parts
+// again.
+func (s *Sequence[T, U]) apply(from, to int, fn func(n *seqNode[T, U])) {
+	if from < 0 || to > s.Len() || from > to {
+		panic(fmt.Sprintf("splay: invalid range [%d, %d) with size %d", from, to, s.Len()))
+	}
+	left, rest := s.split(s.root, from)
+	middle, right := s.split(rest, to-from)
+	fn(middle)
+	s.root = s.merge(s.merge(left, middle), right)
+}
+
+// nodeAt returns the node at index i below root, pushing deferred work on
+// the way down so that the node can be splayed.
+func (s *Sequence[T, U]) nodeAt(root *seqNode[T, U], i int) *seqNode[T, U] {
+	n := root
+	for {
+		s.push(n)
+		switch left := seqSize(n.left); {
+		case i < left:
+			n = n.left
+		case i > left:
+			i -= left + 1
+			n = n.right
+		default:
+			return n
+		}
+	}
+}
+
+// split cuts the tree at root into its first k elements and the rest.
+func (s *Sequence[T, U]) split(root *seqNode[T, U], k int) (*seqNode[T, U], *seqNode[T, U]) {
+	if k == 0 {
+		return nil, root
+	}
+	if k == seqSize(root) {
+		return root, nil
+	}
+	n := s.nodeAt(root, k-1)
+	s.splay(n)
+	right := n.right
+	right.parent = nil
+	n.right = nil
+	s.pull(n)
+	return n, right
+}
+
+// merge joins two detached trees, a before b.
+func (s *Sequence[T, U]) merge(a, b *seqNode[T, U]) *seqNode[T, U] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	n := s.nodeAt(a, a.size-1)
+	s.splay(n)
+	n.right = b
+	b.parent = n
+	s.pull(n)
+	return n
+}
+
+// update applies u to the whole subtree at n, deferring the children.
+func (s *Sequence[T, U]) update(n *seqNode[T, U], u U) {
+	n.value = s.action.Apply(u, n.value, 1)
+	n.aggregate = s.action.Apply(u, n.aggregate, n.size)
+	n.backward = s.action.Apply(u, n.backward, n.size)
+	if n.updated {
+		n.pending = s.action.Compose(u, n.pending)
+	} else {
+		n.pending, n.updated = u, true
+	}
+}
+
+// flip reverses the whole subtree at n, deferring the children.
+func (s *Sequence[T, U]) flip(n *seqNode[T, U]) {
+	n.aggregate, n.backward = n.backward, n.aggregate
+	n.reversed = !n.reversed
+}
+
+// push hands the deferred update and reversal of n down to its children.
+func (s *Sequence[T, U]) push(n *seqNode[T, U]) {
+	if n.reversed {
+		n.left, n.right = n.right, n.left
+		for _, child := range []*seqNode[T, U]{n.left, n.right} {
+			if child != nil {
+				s.flip(child)
+			}
+		}
+		n.reversed = false
+	}
+	if n.updated {
+		for _, child := range []*seqNode[T, U]{n.left, n.right} {
+			if child != nil {
+				s.update(child, n.pending)
+			}
+		}
+		var zero U
+		n.pending, n.updated = zero, false
+	}
+}
+
+// pull recomputes the size and aggregates of n from its children.
+func (s *Sequence[T, U]) pull(n *seqNode[T, U]) {
+	n.size = 1
+	n.aggregate, n.backward = n.value, n.value
+	if n.left != nil {
+		n.size += n.left.size
+		n.aggregate = s.monoid.Combine(n.left.aggregate, n.aggregate)
+		n.backward = s.monoid.Combine(n.backward, n.left.backward)
+	}
+	if n.right != nil {
+		n.size += n.right.size
+		n.aggregate = s.monoid.Combine(n.aggregate, n.right.aggregate)
+		n.backward = s.monoid.Combine(n.right.backward, n.backward)
+	}
+}
+
+// splay rotates x up to the root of its tree. Every ancestor of x must
+// have been pushed.
+func (s *Sequence[T, U]) splay(x *seqNode[T, U]) {
+	for p := x.parent; p != nil; p = x.parent {
+		if g := p.parent; g != nil {
+			if (x == p.left) == (p == g.left) {
+				s.rotate(p)
+			} else {
+				s.rotate(x)
+			}
+		}
+		s.rotate(x)
+	}
+}
+
+// rotate moves x above its parent.
+func (s *Sequence[T, U]) rotate(x *seqNode[T, U]) {
+	p, g := x.parent, x.parent.parent
+	if x == p.left {
+		p.left = x.right
+		if x.right != nil {
+			x.right.parent = p
+		}
+		x.right = p
+	} else {
+		p.right = x.left
+		if x.left != nil {
+			x.left.parent = p
+		}
+		x.left = p
+	}
+	p.parent = x
+	x.parent = g
+	if g != nil {
+		if g.left == p {
+			g.left = x
+		} else {
+			g.right = x
+		}
+	}
+	s.pull(p)
+	s.pull(x)
+}
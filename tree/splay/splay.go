@@ -0,0 +1,363 @@
+package splay
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+)
+
+type node[K any, V any] struct {
+	key    K
+	value  V
+	size   int
+	left   *node[K, V]
+	right  *node[K, V]
+	parent *node[K, V]
+}
+
+func sizeOf[K any, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func (n *node[K, V]) update() {
+	n.size = sizeOf(n.left) + 1 + sizeOf(n.right)
+}
+
+// Tree is a splay tree ordered by key. Every access moves the node it
+// reaches to the root, so operations are O(log n) amortized and recently
+// used keys stay cheap to reach. Because lookups restructure the tree, a
+// Tree is not safe for concurrent reads.
+type Tree[K any, V any] struct {
+	root    *node[K, V]
+	compare func(a, b K) int
+}
+
+// New creates an empty Tree ordered by compare.
+func New[K any, V any](compare func(a, b K) int) *Tree[K, V] {
+	return &Tree[K, V]{compare: compare}
+}
+
+// NewOrdered creates an empty Tree using the natural order of K.
+func NewOrdered[K cmp.Ordered, V any]() *Tree[K, V] {
+	return New[K, V](cmp.Compare[K])
+}
+
+// Len returns the number of entries in the tree.
+func (t *Tree[K, V]) Len() int {
+	return sizeOf(t.root)
+}
+
+// IsEmpty checks if the tree has no entries.
+func (t *Tree[K, V]) IsEmpty() bool {
+	return t.root == nil
+}
+
+// Clear removes every entry.
+func (t *Tree[K, V]) Clear() {
+	t.root = nil
+}
+
+// Get returns the value for key and whether it was found.
+func (t *Tree[K, V]) Get(key K) (V, bool) {
+	if n := t.find(key); n != nil {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains checks if key is in the tree.
+func (t *Tree[K, V]) Contains(key K) bool {
+	return t.find(key) != nil
+}
+
+// Put inserts key with value, or replaces the value if key is present.
+// It reports whether key was inserted.
+func (t *Tree[K, V]) Put(key K, value V) bool {
+	var parent *node[K, V]
+	c := 0
+	for n := t.root; n != nil; {
+		parent = n
+		c = t.compare(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			n.value = value
+			t.splay(n)
+			return false
+		}
+	}
+	n := &node[K, V]{key: key, value: value, size: 1, parent: parent}
+	switch {
+	case parent == nil:
+		t.root = n
+	case c < 0:
+		parent.left = n
+	default:
+		parent.right = n
+	}
+	t.splay(n)
+	return true
+}
+
+// Delete removes key and reports whether it was present.
+func (t *Tree[K, V]) Delete(key K) bool {
+	n := t.find(key)
+	if n == nil {
+		return false
+	}
+	left, right := n.left, n.right
+	if left != nil {
+		left.parent = nil
+	}
+	if right != nil {
+		right.parent = nil
+	}
+	t.root = left
+	t.join(right)
+	return true
+}
+
+// Split moves every entry with a key greater than or equal to key into a
+// new tree and returns it.
+func (t *Tree[K, V]) Split(key K) *Tree[K, V] {
+	right := &Tree[K, V]{compare: t.compare}
+	var ceiling, last *node[K, V]
+	for n := t.root; n != nil; {
+		last = n
+		if t.compare(n.key, key) >= 0 {
+			ceiling = n
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	if ceiling == nil {
+		if last != nil {
+			t.splay(last)
+		}
+		return right
+	}
+	t.splay(ceiling)
+	t.root = ceiling.left
+	if t.root != nil {
+		t.root.parent = nil
+	}
+	ceiling.left = nil
+	ceiling.update()
+	right.root = ceiling
+	return right
+}
+
+// Merge moves every entry of other into t, leaving other empty. Every key
+// of other must be greater than every key of t; Merge panics otherwise.
+func (t *Tree[K, V]) Merge(other *Tree[K, V]) {
+	if t.root != nil && other.root != nil {
+		last, _ := t.At(t.Len() - 1)
+		first, _ := other.At(0)
+		if t.compare(last, first) >= 0 {
+			panic("splay: merged keys overlap")
+		}
+	}
+	t.join(other.root)
+	other.root = nil
+}
+
+// At returns the entry at index i in key order. It panics if i is out of
+// range.
+func (t *Tree[K, V]) At(i int) (K, V) {
+	if i < 0 || i >= t.Len() {
+		panic(fmt.Sprintf("splay: index %d out of range with size %d", i, t.Len()))
+	}
+	n := t.root
+	for {
+		switch left := sizeOf(n.left); {
+		case i < left:
+			n = n.left
+		case i > left:
+			i -= left + 1
+			n = n.right
+		default:
+			t.splay(n)
+			return n.key, n.value
+		}
+	}
+}
+
+// Rank returns the number of keys less than key.
+func (t *Tree[K, V]) Rank(key K) int {
+	rank := 0
+	var last *node[K, V]
+	for n := t.root; n != nil; {
+		last = n
+		if t.compare(key, n.key) <= 0 {
+			n = n.left
+		} else {
+			rank += sizeOf(n.left) + 1
+			n = n.right
+		}
+	}
+	if last != nil {
+		t.splay(last)
+	}
+	return rank
+}
+
+// Each calls fn for every entry in key order until fn returns false.
+// fn must not modify the tree. Each does not restructure the tree.
+func (t *Tree[K, V]) Each(fn func(key K, value V) bool) {
+	n := t.root
+	for n != nil && n.left != nil {
+		n = n.left
+	}
+	for n != nil {
+		if !fn(n.key, n.value) {
+			return
+		}
+		if n.right != nil {
+			n = n.right
+			for n.left != nil {
+				n = n.left
+			}
+			continue
+		}
+		for n.parent != nil && n == n.parent.right {
+			n = n.parent
+		}
+		n = n.parent
+	}
+}
+
+// Keys returns the keys in order.
+func (t *Tree[K, V]) Keys() []K {
+	keys := make([]K, 0, t.Len())
+	t.Each(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Validate checks the binary search tree order, the parent links and the
+// stored subtree sizes. It is meant for tests.
+func (t *Tree[K, V]) Validate() error {
+	if t.root != nil && t.root.parent != nil {
+		return errors.New("splay: root has a parent")
+	}
+	return t.validate(t.root, nil, nil)
+}
+
+// validate checks the subtree at n, whose keys must lie strictly between
+// lo and hi when they are set.
+func (t *Tree[K, V]) validate(n *node[K, V], lo, hi *K) error {
+	if n == nil {
+		return nil
+	}
+	if (lo != nil && t.compare(n.key, *lo) <= 0) || (hi != nil && t.compare(n.key, *hi) >= 0) {
+		return fmt.Errorf("splay: key %v out of order", n.key)
+	}
+	for _, child := range []*node[K, V]{n.left, n.right} {
+		if child != nil && child.parent != n {
+			return fmt.Errorf("splay: node %v has a wrong parent link", child.key)
+		}
+	}
+	if size := sizeOf(n.left) + 1 + sizeOf(n.right); size != n.size {
+		return fmt.Errorf("splay: node %v stores size %d, want %d", n.key, n.size, size)
+	}
+	if err := t.validate(n.left, lo, &n.key); err != nil {
+		return err
+	}
+	return t.validate(n.right, &n.key, hi)
+}
+
+// find splays the node holding key, or the last node reached, and returns
+// the node holding key or nil.
+func (t *Tree[K, V]) find(key K) *node[K, V] {
+	var last *node[K, V]
+	for n := t.root; n != nil; {
+		last = n
+		c := t.compare(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			t.splay(n)
+			return n
+		}
+	}
+	if last != nil {
+		t.splay(last)
+	}
+	return nil
+}
+
+// join appends the detached subtree right, whose keys are all greater,
+// below the maximum of t.
+func (t *Tree[K, V]) join(right *node[K, V]) {
+	if t.root == nil {
+		t.root = right
+		return
+	}
+	n := t.root
+	for n.right != nil {
+		n = n.right
+	}
+	t.splay(n)
+	n.right = right
+	if right != nil {
+		right.parent = n
+	}
+	n.update()
+}
+
+// splay rotates x up to the root.
+func (t *Tree[K, V]) splay(x *node[K, V]) {
+	for p := x.parent; p != nil; p = x.parent {
+		if g := p.parent; g != nil {
+			if (x == p.left) == (p == g.left) {
+				rotate(p)
+			} else {
+				rotate(x)
+			}
+		}
+		rotate(x)
+	}
+	t.root = x
+}
+
+// rotate moves x above its parent.
+func rotate[K any, V any](x *node[K, V]) {
+	p, g := x.parent, x.parent.parent
+	if x == p.left {
+		p.left = x.right
+		if x.right != nil {
+			x.right.parent = p
+		}
+		x.right = p
+	} else {
+		p.right = x.left
+		if x.left != nil {
+			x.left.parent = p
+		}
+		x.left = p
+	}
+	p.parent = x
+	x.parent = g
+	if g != nil {
+		if g.left == p {
+			g.left = x
+		} else {
+			g.right = x
+		}
+	}
+	p.update()
+	x.update()
+}
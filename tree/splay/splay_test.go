@@ -0,0 +1,295 @@
+package splay_test
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/monoid"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/splay"
+	"github.com/stretchr/testify/assert"
+)
+
+// concat is a monoid that is not commutative, so aggregates show whether
+// reversals keep the order of the elements.
+func concat() monoid.Monoid[string] {
+	return monoid.Monoid[string]{Combine: func(a, b string) string { return a + b }}
+}
+
+// noUpdate is an action for sequences that are never updated.
+func noUpdate() monoid.Action[string, struct{}] {
+	return monoid.Action[string, struct{}]{
+		Apply:   func(_ struct{}, aggregate string, _ int) string { return aggregate },
+		Compose: func(struct{}, struct{}) struct{} { return struct{}{} },
+	}
+}
+
+func root(tree *splay.Tree[int, int]) int {
+	return tree.Preorder()[0]
+}
+
+func TestTree_BasicOperations(t *testing.T) {
+	tree := splay.NewOrdered[int, string]()
+	assert.True(t, tree.IsEmpty())
+
+	assert.True(t, tree.Put(20, "b"))
+	assert.True(t, tree.Put(10, "a"))
+	assert.True(t, tree.Put(30, "c"))
+	assert.False(t, tree.Put(20, "B"))
+	assert.Equal(t, 3, tree.Len())
+	assert.NoError(t, tree.Validate())
+
+	value, ok := tree.Get(20)
+	assert.True(t, ok)
+	assert.Equal(t, "B", value)
+	assert.False(t, tree.Contains(15))
+	assert.Equal(t, []int{10, 20, 30}, tree.Keys())
+
+	key, value := tree.At(2)
+	assert.Equal(t, 30, key)
+	assert.Equal(t, "c", value)
+	assert.Equal(t, 1, tree.Rank(20))
+	assert.Panics(t, func() { tree.At(3) })
+
+	assert.True(t, tree.Delete(20))
+	assert.False(t, tree.Delete(20))
+	assert.Equal(t, []int{10, 30}, tree.Keys())
+	assert.NoError(t, tree.Validate())
+
+	tree.Clear()
+	assert.Equal(t, 0, tree.Len())
+}
+
+func TestTree_AccessSplaysToRoot(t *testing.T) {
+	tree := splay.NewOrdered[int, int]()
+	for i := 0; i < 100; i++ {
+		tree.Put(i, i)
+		assert.Equal(t, i, root(tree))
+	}
+
+	tree.Get(37)
+	assert.Equal(t, 37, root(tree))
+	tree.At(5)
+	assert.Equal(t, 5, root(tree))
+	// A missed lookup splays the last node on the search path
+	assert.False(t, tree.Contains(1000))
+	assert.Equal(t, 99, root(tree))
+	// Rank splays the node where its search stopped, next to the key
+	assert.Equal(t, 50, tree.Rank(50))
+	assert.Contains(t, []int{49, 50}, root(tree))
+	tree.Put(60, -1)
+	assert.Equal(t, 60, root(tree))
+
+	// Deleting the root joins its subtrees under its predecessor
+	tree.Delete(60)
+	assert.Equal(t, 59, root(tree))
+	assert.NoError(t, tree.Validate())
+}
+
+func TestTree_EachKeepsShape(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	tree := splay.NewOrdered[int, int]()
+	for _, key := range rng.Perm(200) {
+		tree.Put(key, key)
+	}
+	tree.Get(123)
+
+	shape := tree.Preorder()
+	count := 0
+	tree.Each(func(key, value int) bool {
+		assert.Equal(t, count, key)
+		count++
+		return count < 150
+	})
+	assert.Equal(t, 150, count)
+	assert.Len(t, tree.Keys(), 200)
+	assert.Equal(t, shape, tree.Preorder())
+}
+
+func TestTree_SplitAndMerge(t *testing.T) {
+	tree := splay.NewOrdered[int, int]()
+	for i := 0; i < 100; i++ {
+		tree.Put(i, i)
+	}
+
+	right := tree.Split(60)
+	assert.Equal(t, 60, tree.Len())
+	assert.Equal(t, 40, right.Len())
+	// The split point ends up at the root of the right tree
+	assert.Equal(t, 60, root(right))
+	assert.NoError(t, tree.Validate())
+	assert.NoError(t, right.Validate())
+
+	assert.Equal(t, 0, tree.Split(100).Len())
+	assert.NoError(t, tree.Validate())
+
+	assert.Panics(t, func() { right.Merge(tree) })
+	tree.Merge(right)
+	assert.Equal(t, 100, tree.Len())
+	assert.True(t, right.IsEmpty())
+	assert.NoError(t, tree.Validate())
+	for i, key := range tree.Keys() {
+		assert.Equal(t, i, key)
+	}
+}
+
+func TestTree_RandomOperationsKeepInvariants(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	tree := splay.NewOrdered[int, int]()
+	reference := map[int]int{}
+
+	for i := 0; i < 5000; i++ {
+		key := rng.Intn(500)
+		_, exists := reference[key]
+		switch rng.Intn(4) {
+		case 0:
+			assert.Equal(t, exists, tree.Delete(key))
+			delete(reference, key)
+		case 1:
+			value, ok := tree.Get(key)
+			assert.Equal(t, exists, ok)
+			assert.Equal(t, reference[key], value)
+		default:
+			assert.Equal(t, !exists, tree.Put(key, i))
+			reference[key] = i
+		}
+		if i%100 == 0 {
+			if err := tree.Validate(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	keys := make([]int, 0, len(reference))
+	for key := range reference {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	assert.Equal(t, keys, tree.Keys())
+	for i, key := range keys {
+		assert.Equal(t, i, tree.Rank(key))
+	}
+	assert.NoError(t, tree.Validate())
+}
+
+func TestSequence_RangeOperations(t *testing.T) {
+	s := splay.NewSequenceFrom([]int{1, 2, 3, 4, 5, 6}, monoid.Sum[int](), monoid.AddToSum[int]())
+	assert.NoError(t, s.Validate())
+	assert.Equal(t, 21, s.Query(0, 6))
+	assert.Equal(t, 9, s.Query(1, 4))
+	assert.Equal(t, 0, s.Query(2, 2))
+
+	s.Reverse(1, 5)
+	s.Update(0, 3, 10)
+	assert.Equal(t, []int{11, 15, 14, 3, 2, 6}, s.Values())
+	assert.Equal(t, 40, s.Query(0, 3))
+
+	s.Insert(2, 100)
+	assert.Equal(t, 100, s.Get(2))
+	assert.Equal(t, 100, s.Delete(2))
+	s.Set(5, 7)
+	assert.Equal(t, []int{11, 15, 14, 3, 2, 7}, s.Values())
+	assert.NoError(t, s.Validate())
+
+	assert.Panics(t, func() { s.Get(6) })
+	assert.Panics(t, func() { s.Query(4, 2) })
+}
+
+func TestSequence_SplitAndConcatKeepParentLinks(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	values := make([]int, 64)
+	for i := range values {
+		values[i] = i
+	}
+	s := splay.NewSequenceFrom(values, monoid.Sum[int](), monoid.AddToSum[int]())
+
+	// Rotate the sequence by random amounts; every cut and join relinks
+	// parents below the splayed nodes
+	model := slices.Clone(values)
+	for i := 0; i < 200; i++ {
+		k := rng.Intn(len(model) + 1)
+		tail := s.Split(k)
+		assert.NoError(t, s.Validate())
+		assert.NoError(t, tail.Validate())
+		tail.Concat(s)
+		s = tail
+		model = append(model[k:], model[:k]...)
+		assert.NoError(t, s.Validate())
+
+		j := rng.Intn(len(model))
+		assert.Equal(t, model[j], s.Get(j))
+		assert.NoError(t, s.Validate())
+	}
+	assert.Equal(t, model, s.Values())
+
+	for len(model) > 0 {
+		j := rng.Intn(len(model))
+		assert.Equal(t, model[j], s.Delete(j))
+		model = slices.Delete(model, j, j+1)
+		assert.NoError(t, s.Validate())
+	}
+	assert.Equal(t, 0, s.Len())
+}
+
+func TestSequence_MatchesSlice(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	s := splay.NewSequence(monoid.Sum[int](), monoid.AddToSum[int]())
+	var model []int
+
+	for i := 0; i < 3000; i++ {
+		from := rng.Intn(len(model) + 1)
+		to := from + rng.Intn(len(model)-from+1)
+		switch op := rng.Intn(5); {
+		case op == 0 || len(model) == 0:
+			value := rng.Intn(100)
+			s.Insert(from, value)
+			model = slices.Insert(model, from, value)
+		case op == 1 && from < len(model):
+			assert.Equal(t, model[from], s.Delete(from))
+			model = slices.Delete(model, from, from+1)
+		case op == 2:
+			s.Reverse(from, to)
+			slices.Reverse(model[from:to])
+		case op == 3:
+			delta := rng.Intn(21) - 10
+			s.Update(from, to, delta)
+			for j := from; j < to; j++ {
+				model[j] += delta
+			}
+		default:
+			sum := 0
+			for _, value := range model[from:to] {
+				sum += value
+			}
+			assert.Equal(t, sum, s.Query(from, to))
+		}
+		if i%100 == 0 {
+			if err := s.Validate(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	assert.Equal(t, model, s.Values())
+}
+
+func TestSequence_ReverseWithOrderedMonoid(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+	model := []byte("abcdefghijklmnopqrstuvwxyz")
+	values := make([]string, len(model))
+	for i, c := range model {
+		values[i] = string(c)
+	}
+	s := splay.NewSequenceFrom(values, concat(), noUpdate())
+
+	for i := 0; i < 500; i++ {
+		from := rng.Intn(len(model) + 1)
+		to := from + rng.Intn(len(model)-from+1)
+		if rng.Intn(2) == 0 {
+			s.Reverse(from, to)
+			slices.Reverse(model[from:to])
+		} else {
+			assert.Equal(t, string(model[from:to]), s.Query(from, to))
+		}
+	}
+	assert.Equal(t, string(model), s.Query(0, len(model)))
+}
@@ -0,0 +1,13 @@
+package treap
+
+// Height returns the number of nodes on the longest path from the root.
+func (t *Tree[K, V]) Height() int {
+	var height func(n *node[K, V]) int
+	height = func(n *node[K, V]) int {
+		if n == nil {
+			return 0
+		}
+		return max(height(n.left), height(n.right)) + 1
+	}
+	return height(t.root)
+}
@@ -0,0 +1,343 @@
+package treap
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/monoid"
+)
+
+// seqNode is a node of an implicit treap. backward is the aggregate of the
+// subtree read right to left, which a reversal swaps with aggregate. The
+// value and both aggregates already include the pending update and
+// reversal; the children do not yet.
+type seqNode[T any, U any] struct {
+	value     T
+	aggregate T
+	backward  T
+	pending   U
+	updated   bool
+	reversed  bool
+	priority  uint64
+	size      int
+	left      *seqNode[T, U]
+	right     *seqNode[T, U]
+}
+
+func seqSize[T any, U any](n *seqNode[T, U]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// Sequence is an implicit treap: the position of an element is its key,
+// so elements can be inserted, removed, cut out and joined anywhere in
+// O(log n). It keeps the aggregate of every subtree under a monoid and
+// applies range updates and reversals lazily.
+type Sequence[T any, U any] struct {
+	root   *seqNode[T, U]
+	monoid monoid.Monoid[T]
+	action monoid.Action[T, U]
+}
+
+// NewSequence creates an empty Sequence that aggregates values with m and
+// applies range updates with action.
+func NewSequence[T any, U any](m monoid.Monoid[T], action monoid.Action[T, U]) *Sequence[T, U] {
+	return &Sequence[T, U]{monoid: m, action: action}
+}
+
+// NewSequenceFrom creates a Sequence holding values in order, built in
+// O(n).
+func NewSequenceFrom[T any, U any](values []T, m monoid.Monoid[T], action monoid.Action[T, U]) *Sequence[T, U] {
+	s := NewSequence(m, action)
+	// The right spine of the treap built so far, from the root down
+	var spine []*seqNode[T, U]
+	for _, value := range values {
+		n := s.newNode(value)
+		var last *seqNode[T, U]
+		for len(spine) > 0 && spine[len(spine)-1].priority < n.priority {
+			last = spine[len(spine)-1]
+			spine = spine[:len(spine)-1]
+			s.pull(last)
+		}
+		n.left = last
+		if len(spine) > 0 {
+			spine[len(spine)-1].right = n
+		}
+		spine = append(spine, n)
+	}
+	for i := len(spine) - 1; i >= 0; i-- {
+		s.pull(spine[i])
+	}
+	if len(spine) > 0 {
+		s.root = spine[0]
+	}
+	return s
+}
+
+// Len returns the number of elements.
+func (s *Sequence[T, U]) Len() int {
+	return seqSize(s.root)
+}
+
+// Get returns the element at index i. It panics if i is out of range.
+func (s *Sequence[T, U]) Get(i int) T {
+	s.checkIndex(i, s.Len())
+	return s.nodeAt(i).value
+}
+
+// Set replaces the element at index i. It panics if i is out of range.
+func (s *Sequence[T, U]) Set(i int, value T) {
+	s.checkIndex(i, s.Len())
+	left, rest := s.split(s.root, i)
+	n, right := s.split(rest, 1)
+	n.value = value
+	s.pull(n)
+	s.root = s.merge(s.merge(left, n), right)
+}
+
+// Insert inserts value at index i, shifting later elements up. i may be
+// equal to Len to append. It panics if i is out of range.
+func (s *Sequence[T, U]) Insert(i int, value T) {
+	s.checkIndex(i, s.Len()+1)
+	left, right := s.split(s.root, i)
+	s.root = s.merge(s.merge(left, s.newNode(value)), right)
+}
+
+// Append adds value at the end.
+func (s *Sequence[T, U]) Append(value T) {
+	s.root = s.merge(s.root, s.newNode(value))
+}
+
+// Delete removes the element at index i and returns it. It panics if i is
+// out of range.
+func (s *Sequence[T, U]) Delete(i int) T {
+	s.checkIndex(i, s.Len())
+	left, rest := s.split(s.root, i)
+	n, right := s.split(rest, 1)
+	s.root = s.merge(left, right)
+	return n.value
+}
+
+// Split moves the elements from index i on into a new Sequence and
+// returns it. It panics if i is out of range.
+func (s *Sequence[T, U]) Split(i int) *Sequence[T, U] {
+	s.checkIndex(i, s.Len()+1)
+	var right *seqNode[T, U]
+	s.root, right = s.split(s.root, i)
+	return &Sequence[T, U]{root: right, monoid: s.monoid, action: s.action}
+}
+
+// Concat appends every element of other, leaving other empty.
+func (s *Sequence[T, U]) Concat(other *Sequence[T, U]) {
+	s.root = s.merge(s.root, other.root)
+	other.root = nil
+}
+
+// Query returns the aggregate of the elements in [from, to). It panics if
+// the range is invalid.
+func (s *Sequence[T, U]) Query(from, to int) T {
+	result := s.monoid.Identity
+	s.apply(from, to, func(n *seqNode[T, U]) {
+		if n != nil {
+			result = n.aggregate
+		}
+	})
+	return result
+}
+
+// Update applies u to every element in [from, to). It panics if the
+// range is invalid.
+func (s *Sequence[T, U]) Update(from, to int, u U) {
+	s.apply(from, to, func(n *seqNode[T, U]) {
+		if n != nil {
+			s.update(n, u)
+		}
+	})
+}
+
+// Reverse reverses the order of the elements in [from, to). The monoid
+// need not be commutative: every node also keeps the aggregate of its
+// subtree in reverse order. It panics if the range is invalid.
+func (s *Sequence[T, U]) Reverse(from, to int) {
+	s.apply(from, to, func(n *seqNode[T, U]) {
+		if n != nil {
+			s.flip(n)
+		}
+	})
+}
+
+// Values returns the elements in order.
+func (s *Sequence[T, U]) Values() []T {
+	values := make([]T, 0, s.Len())
+	var walk func(n *seqNode[T, U])
+	walk = func(n *seqNode[T, U]) {
+		if n == nil {
+			return
+		}
+		s.push(n)
+		walk(n.left)
+		values = append(values, n.value)
+		walk(n.right)
+	}
+	walk(s.root)
+	return values
+}
+
+// Validate checks the heap order on the priorities and the stored subtree
+// sizes. It is meant for tests.
+func (s *Sequence[T, U]) Validate() error {
+	return validateSeq(s.root)
+}
+
+func validateSeq[T any, U any](n *seqNode[T, U]) error {
+	if n == nil {
+		return nil
+	}
+	for _, child := range []*seqNode[T, U]{n.left, n.right} {
+		if child != nil && child.priority > n.priority {
+			return errors.New("treap: child has a higher priority than its parent")
+		}
+	}
+	if size := seqSize(n.left) + 1 + seqSize(n.right); size != n.size {
+		return fmt.Errorf("treap: node stores size %d, want %d", n.size, size)
+	}
+	if err := validateSeq(n.left); err != nil {
+		return err
+	}
+	return validateSeq(n.right)
+}
+
+func (s *Sequence[T, U]) newNode(value T) *seqNode[T, U] {
+	return &seqNode[T, U]{value: value, aggregate: value, backward: value, priority: rand.Uint64(), size: 1}
+}
+
+func (s *Sequence[T, U]) checkIndex(i, size int) {
+	if i < 0 || i >= size {
+		panic(fmt.Sprintf("treap: index %d out of range with size %d", i, s.Len()))
+	}
+}
+
+// apply cuts out [from, to), passes its root to fn and joins the parts
+// again.
+func (s *Sequence[T, U]) apply(from, to int, fn func(n *seqNode[T, U])) {
+	if from < 0 || to > s.Len() || from > to {
+		panic(fmt.Sprintf("treap: invalid range [%d, %d) with size %d", from, to, s.Len()))
+	}
+	left, rest := s.split(s.root, from)
+	middle, right := s.split(rest, to-from)
+	fn(middle)
+	s.root = s.merge(s.merge(left, middle), right)
+}
+
+func (s *Sequence[T, U]) nodeAt(i int) *seqNode[T, U] {
+	n := s.root
+	for {
+		s.push(n)
+		switch left := seqSize(n.left); {
+		case i < left:
+			n = n.left
+		case i > left:
+			i -= left + 1
+			n = n.right
+		default:
+			return n
+		}
+	}
+}
+
+// update applies u to the whole subtree at n, deferring the children.
+func (s *Sequence[T, U]) update(n *seqNode[T, U], u U) {
+	n.value = s.action.Apply(u, n.value, 1)
+	n.aggregate = s.action.Apply(u, n.aggregate, n.size)
+	n.backward = s.action.Apply(u, n.backward, n.size)
+	if n.updated {
+		n.pending = s.action.Compose(u, n.pending)
+	} else {
+		n.pending, n.updated = u, true
+	}
+}
+
+// flip reverses the whole subtree at n, deferring the children.
+func (s *Sequence[T, U]) flip(n *seqNode[T, U]) {
+	n.aggregate, n.backward = n.backward, n.aggregate
+	n.reversed = !n.reversed
+}
+
+// push hands the deferred update and reversal of n down to its children.
+func (s *Sequence[T, U]) push(n *seqNode[T, U]) {
+	if n.reversed {
+		n.left, n.right = n.right, n.left
+		for _, child := range []*seqNode[T, U]{n.left, n.right} {
+			if child != nil {
+				s.flip(child)
+			}
+		}
+		n.reversed = false
+	}
+	if n.updated {
+		for _, child := range []*seqNode[T, U]{n.left, n.right} {
+			if child != nil {
+				s.update(child, n.pending)
+			}
+		}
+		var zero U
+		n.pending, n.updated = zero, false
+	}
+}
+
+// pull recomputes the size and aggregates of n from its children.
+func (s *Sequence[T, U]) pull(n *seqNode[T, U]) {
+	n.size = 1
+	n.aggregate, n.backward = n.value, n.value
+	if n.left != nil {
+		n.size += n.left.size
+		n.aggregate = s.monoid.Combine(n.left.aggregate, n.aggregate)
+		n.backward = s.monoid.Combine(n.backward, n.left.backward)
+	}
+	if n.right != nil {
+		n.size += n.right.size
+		n.aggregate = s.monoid.Combine(n.aggregate, n.right.aggregate)
+		n.backward = s.monoid.Combine(n.right.backward, n.backward)
+	}
+}
+
+// split cuts the subtree at n into its first k elements and the rest.
+func (s *Sequence[T, U]) split(n *seqNode[T, U], k int) (*seqNode[T, U], *seqNode[T, U]) {
+	if n == nil {
+		return nil, nil
+	}
+	s.push(n)
+	left := seqSize(n.left)
+	if k <= left {
+		l, r := s.split(n.left, k)
+		n.left = r
+		s.pull(n)
+		return l, n
+	}
+	l, r := s.split(n.right, k-left-1)
+	n.right = l
+	s.pull(n)
+	return n, r
+}
+
+func (s *Sequence[T, U]) merge(a, b *seqNode[T, U]) *seqNode[T, U] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if a.priority > b.priority {
+		s.push(a)
+		a.right = s.merge(a.right, b)
+		s.pull(a)
+		return a
+	}
+	s.push(b)
+	b.left = s.merge(a, b.left)
+	s.pull(b)
+	return b
+}
@@ -0,0 +1,271 @@
+package treap
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand/v2"
+)
+
+type node[K any, V any] struct {
+	key      K
+	value    V
+	priority uint64
+	size     int
+	left     *node[K, V]
+	right    *node[K, V]
+}
+
+func sizeOf[K any, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func (n *node[K, V]) update() {
+	n.size = sizeOf(n.left) + 1 + sizeOf(n.right)
+}
+
+// Tree is a treap ordered by key: a binary search tree on the keys and a
+// heap on random priorities, which keeps it balanced in expectation.
+// Split and Merge cut and join whole trees in O(log n).
+type Tree[K any, V any] struct {
+	root    *node[K, V]
+	compare func(a, b K) int
+}
+
+// New creates an empty Tree ordered by compare.
+func New[K any, V any](compare func(a, b K) int) *Tree[K, V] {
+	return &Tree[K, V]{compare: compare}
+}
+
+// NewOrdered creates an empty Tree using the natural order of K.
+func NewOrdered[K cmp.Ordered, V any]() *Tree[K, V] {
+	return New[K, V](cmp.Compare[K])
+}
+
+// Len returns the number of entries in the tree.
+func (t *Tree[K, V]) Len() int {
+	return sizeOf(t.root)
+}
+
+// IsEmpty checks if the tree has no entries.
+func (t *Tree[K, V]) IsEmpty() bool {
+	return t.root == nil
+}
+
+// Clear removes every entry.
+func (t *Tree[K, V]) Clear() {
+	t.root = nil
+}
+
+// Get returns the value for key and whether it was found.
+func (t *Tree[K, V]) Get(key K) (V, bool) {
+	if n := t.find(key); n != nil {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains checks if key is in the tree.
+func (t *Tree[K, V]) Contains(key K) bool {
+	return t.find(key) != nil
+}
+
+// Put inserts key with value, or replaces the value if key is present.
+// It reports whether key was inserted.
+func (t *Tree[K, V]) Put(key K, value V) bool {
+	if n := t.find(key); n != nil {
+		n.value = value
+		return false
+	}
+	left, right := t.split(t.root, key)
+	n := &node[K, V]{key: key, value: value, priority: rand.Uint64(), size: 1}
+	t.root = merge(merge(left, n), right)
+	return true
+}
+
+// Delete removes key and reports whether it was present.
+func (t *Tree[K, V]) Delete(key K) bool {
+	var removed bool
+	t.root = t.remove(t.root, key, &removed)
+	return removed
+}
+
+// Split moves every entry with a key greater than or equal to key into a
+// new tree and returns it.
+func (t *Tree[K, V]) Split(key K) *Tree[K, V] {
+	var right *node[K, V]
+	t.root, right = t.split(t.root, key)
+	return &Tree[K, V]{root: right, compare: t.compare}
+}
+
+// Merge moves every entry of other into t, leaving other empty. Every key
+// of other must be greater than every key of t; Merge panics otherwise.
+func (t *Tree[K, V]) Merge(other *Tree[K, V]) {
+	if t.root != nil && other.root != nil {
+		last, _ := t.At(t.Len() - 1)
+		first, _ := other.At(0)
+		if t.compare(last, first) >= 0 {
+			panic("treap: merged keys overlap")
+		}
+	}
+	t.root = merge(t.root, other.root)
+	other.root = nil
+}
+
+// At returns the entry at index i in key order. It panics if i is out of
+// range.
+func (t *Tree[K, V]) At(i int) (K, V) {
+	if i < 0 || i >= t.Len() {
+		panic(fmt.Sprintf("treap: index %d out of range with size %d", i, t.Len()))
+	}
+	n := t.root
+	for {
+		switch left := sizeOf(n.left); {
+		case i < left:
+			n = n.left
+		case i > left:
+			i -= left + 1
+			n = n.right
+		default:
+			return n.key, n.value
+		}
+	}
+}
+
+// Rank returns the number of keys less than key.
+func (t *Tree[K, V]) Rank(key K) int {
+	rank := 0
+	for n := t.root; n != nil; {
+		if t.compare(key, n.key) <= 0 {
+			n = n.left
+		} else {
+			rank += sizeOf(n.left) + 1
+			n = n.right
+		}
+	}
+	return rank
+}
+
+// Each calls fn for every entry in key order until fn returns false.
+// fn must not modify the tree.
+func (t *Tree[K, V]) Each(fn func(key K, value V) bool) {
+	each(t.root, fn)
+}
+
+// Keys returns the keys in order.
+func (t *Tree[K, V]) Keys() []K {
+	keys := make([]K, 0, t.Len())
+	t.Each(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Validate checks the binary search tree order on the keys, the heap
+// order on the priorities and the stored subtree sizes.
+// It is meant for tests.
+func (t *Tree[K, V]) Validate() error {
+	return t.validate(t.root, nil, nil)
+}
+
+// validate checks the subtree at n, whose keys must lie strictly between
+// lo and hi when they are set.
+func (t *Tree[K, V]) validate(n *node[K, V], lo, hi *K) error {
+	if n == nil {
+		return nil
+	}
+	if (lo != nil && t.compare(n.key, *lo) <= 0) || (hi != nil && t.compare(n.key, *hi) >= 0) {
+		return fmt.Errorf("treap: key %v out of order", n.key)
+	}
+	for _, child := range []*node[K, V]{n.left, n.right} {
+		if child != nil && child.priority > n.priority {
+			return fmt.Errorf("treap: node %v has a higher priority than its parent", child.key)
+		}
+	}
+	if size := sizeOf(n.left) + 1 + sizeOf(n.right); size != n.size {
+		return fmt.Errorf("treap: node %v stores size %d, want %d", n.key, n.size, size)
+	}
+	if err := t.validate(n.left, lo, &n.key); err != nil {
+		return err
+	}
+	return t.validate(n.right, &n.key, hi)
+}
+
+func (t *Tree[K, V]) find(key K) *node[K, V] {
+	n := t.root
+	for n != nil {
+		c := t.compare(key, n.key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+// split cuts the subtree at n into the keys below key and the rest.
+func (t *Tree[K, V]) split(n *node[K, V], key K) (*node[K, V], *node[K, V]) {
+	if n == nil {
+		return nil, nil
+	}
+	if t.compare(n.key, key) < 0 {
+		var right *node[K, V]
+		n.right, right = t.split(n.right, key)
+		n.update()
+		return n, right
+	}
+	left, right := t.split(n.left, key)
+	n.left = right
+	n.update()
+	return left, n
+}
+
+func (t *Tree[K, V]) remove(n *node[K, V], key K, removed *bool) *node[K, V] {
+	if n == nil {
+		return nil
+	}
+	switch c := t.compare(key, n.key); {
+	case c < 0:
+		n.left = t.remove(n.left, key, removed)
+	case c > 0:
+		n.right = t.remove(n.right, key, removed)
+	default:
+		*removed = true
+		return merge(n.left, n.right)
+	}
+	n.update()
+	return n
+}
+
+// merge joins two subtrees where every key of a is below every key of b.
+func merge[K any, V any](a, b *node[K, V]) *node[K, V] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if a.priority > b.priority {
+		a.right = merge(a.right, b)
+		a.update()
+		return a
+	}
+	b.left = merge(a, b.left)
+	b.update()
+	return b
+}
+
+func each[K any, V any](n *node[K, V], fn func(key K, value V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return each(n.left, fn) && fn(n.key, n.value) && each(n.right, fn)
+}
@@ -0,0 +1,258 @@
+package treap_test
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/monoid"
+	"github.com/alasgarovnamig/go-dsa-and-algorithm/tree/treap"
+	"github.com/stretchr/testify/assert"
+)
+
+// concat is a monoid that is not commutative, so aggregates show whether
+// reversals keep the order of the elements.
+func concat() monoid.Monoid[string] {
+	return monoid.Monoid[string]{Combine: func(a, b string) string { return a + b }}
+}
+
+// noUpdate is an action for sequences that are never updated.
+func noUpdate() monoid.Action[string, struct{}] {
+	return monoid.Action[string, struct{}]{
+		Apply:   func(_ struct{}, aggregate string, _ int) string { return aggregate },
+		Compose: func(struct{}, struct{}) struct{} { return struct{}{} },
+	}
+}
+
+func TestTree_BasicOperations(t *testing.T) {
+	tree := treap.NewOrdered[int, string]()
+	assert.True(t, tree.IsEmpty())
+
+	assert.True(t, tree.Put(20, "b"))
+	assert.True(t, tree.Put(10, "a"))
+	assert.True(t, tree.Put(30, "c"))
+	assert.False(t, tree.Put(20, "B"))
+	assert.Equal(t, 3, tree.Len())
+
+	value, ok := tree.Get(20)
+	assert.True(t, ok)
+	assert.Equal(t, "B", value)
+	assert.False(t, tree.Contains(15))
+	assert.Equal(t, []int{10, 20, 30}, tree.Keys())
+
+	key, value := tree.At(2)
+	assert.Equal(t, 30, key)
+	assert.Equal(t, "c", value)
+	assert.Equal(t, 1, tree.Rank(20))
+	assert.Equal(t, 2, tree.Rank(25))
+	assert.Panics(t, func() { tree.At(3) })
+
+	assert.True(t, tree.Delete(20))
+	assert.False(t, tree.Delete(20))
+	assert.Equal(t, []int{10, 30}, tree.Keys())
+	assert.NoError(t, tree.Validate())
+
+	tree.Clear()
+	assert.Equal(t, 0, tree.Len())
+}
+
+func TestTree_BalancedOnSortedInput(t *testing.T) {
+	// Random priorities keep the expected depth logarithmic even when the
+	// keys arrive in order, which would degenerate an unbalanced tree
+	tree := treap.NewOrdered[int, int]()
+	for i := 0; i < 1<<14; i++ {
+		tree.Put(i, i)
+	}
+	assert.NoError(t, tree.Validate())
+	assert.Less(t, tree.Height(), 64)
+
+	for i := 0; i < 1<<14; i += 2 {
+		tree.Delete(i)
+	}
+	assert.NoError(t, tree.Validate())
+	assert.Less(t, tree.Height(), 64)
+}
+
+func TestTree_SplitAndMerge(t *testing.T) {
+	tree := treap.NewOrdered[int, int]()
+	for i := 0; i < 100; i += 2 {
+		tree.Put(i, i)
+	}
+
+	// Keys at or above the split key move out, present or not
+	right := tree.Split(61)
+	assert.Equal(t, 31, tree.Len())
+	assert.Equal(t, 19, right.Len())
+	key, _ := right.At(0)
+	assert.Equal(t, 62, key)
+	assert.NoError(t, tree.Validate())
+	assert.NoError(t, right.Validate())
+
+	assert.Equal(t, 0, tree.Split(1000).Len())
+	all := tree.Split(-1)
+	assert.Equal(t, 0, tree.Len())
+	assert.Equal(t, 31, all.Len())
+
+	assert.Panics(t, func() { right.Merge(all) })
+	all.Merge(right)
+	assert.Equal(t, 50, all.Len())
+	assert.True(t, right.IsEmpty())
+	assert.NoError(t, all.Validate())
+	for i, key := range all.Keys() {
+		assert.Equal(t, 2*i, key)
+	}
+}
+
+func TestTree_RandomOperationsKeepInvariants(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	tree := treap.NewOrdered[int, int]()
+	reference := map[int]int{}
+
+	for i := 0; i < 5000; i++ {
+		key := rng.Intn(500)
+		_, exists := reference[key]
+		if rng.Intn(3) == 0 {
+			assert.Equal(t, exists, tree.Delete(key))
+			delete(reference, key)
+		} else {
+			assert.Equal(t, !exists, tree.Put(key, i))
+			reference[key] = i
+		}
+		if i%100 == 0 {
+			if err := tree.Validate(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	keys := make([]int, 0, len(reference))
+	for key := range reference {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	assert.Equal(t, keys, tree.Keys())
+	for i, key := range keys {
+		assert.Equal(t, i, tree.Rank(key))
+		k, v := tree.At(i)
+		assert.Equal(t, key, k)
+		assert.Equal(t, reference[key], v)
+	}
+}
+
+func TestSequence_BuildKeepsHeapOrder(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 7, 100, 1000} {
+		values := make([]int, n)
+		for i := range values {
+			values[i] = i
+		}
+		s := treap.NewSequenceFrom(values, monoid.Sum[int](), monoid.AddToSum[int]())
+		assert.NoError(t, s.Validate())
+		assert.Equal(t, n, s.Len())
+		assert.Equal(t, n*(n-1)/2, s.Query(0, n))
+		assert.Equal(t, values, s.Values())
+	}
+}
+
+func TestSequence_RangeOperations(t *testing.T) {
+	s := treap.NewSequenceFrom([]int{1, 2, 3, 4, 5, 6}, monoid.Sum[int](), monoid.AddToSum[int]())
+	assert.Equal(t, 21, s.Query(0, 6))
+	assert.Equal(t, 9, s.Query(1, 4))
+	assert.Equal(t, 0, s.Query(2, 2))
+
+	s.Reverse(1, 5)
+	assert.Equal(t, []int{1, 5, 4, 3, 2, 6}, s.Values())
+
+	s.Update(0, 3, 10)
+	assert.Equal(t, []int{11, 15, 14, 3, 2, 6}, s.Values())
+	assert.Equal(t, 40, s.Query(0, 3))
+
+	s.Insert(2, 100)
+	assert.Equal(t, 100, s.Get(2))
+	assert.Equal(t, 100, s.Delete(2))
+	s.Set(5, 7)
+
+	tail := s.Split(3)
+	assert.Equal(t, []int{11, 15, 14}, s.Values())
+	assert.Equal(t, []int{3, 2, 7}, tail.Values())
+	tail.Concat(s)
+	assert.Equal(t, []int{3, 2, 7, 11, 15, 14}, tail.Values())
+	assert.Equal(t, 0, s.Len())
+	assert.NoError(t, tail.Validate())
+
+	assert.Panics(t, func() { tail.Get(6) })
+	assert.Panics(t, func() { tail.Query(4, 2) })
+}
+
+func TestSequence_AssignAndMin(t *testing.T) {
+	s := treap.NewSequence(monoid.Min(math.MaxInt), monoid.AssignToExtreme[int]())
+	for _, value := range []int{5, 3, 8, 1, 9} {
+		s.Append(value)
+	}
+	assert.Equal(t, 1, s.Query(0, 5))
+	assert.Equal(t, math.MaxInt, s.Query(3, 3))
+
+	s.Update(2, 5, 4)
+	assert.Equal(t, []int{5, 3, 4, 4, 4}, s.Values())
+	assert.Equal(t, 4, s.Query(2, 5))
+	assert.Equal(t, 3, s.Query(0, 5))
+}
+
+func TestSequence_MatchesSlice(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	s := treap.NewSequence(monoid.Sum[int](), monoid.AddToSum[int]())
+	var model []int
+
+	for i := 0; i < 3000; i++ {
+		from := rng.Intn(len(model) + 1)
+		to := from + rng.Intn(len(model)-from+1)
+		switch op := rng.Intn(6); {
+		case op == 0 || len(model) == 0:
+			value := rng.Intn(100)
+			s.Insert(from, value)
+			model = slices.Insert(model, from, value)
+		case op == 1 && from < len(model):
+			assert.Equal(t, model[from], s.Delete(from))
+			model = slices.Delete(model, from, from+1)
+		case op == 2:
+			s.Reverse(from, to)
+			slices.Reverse(model[from:to])
+		case op == 3:
+			delta := rng.Intn(21) - 10
+			s.Update(from, to, delta)
+			for j := from; j < to; j++ {
+				model[j] += delta
+			}
+		case op == 4:
+			tail := s.Split(from)
+			tail.Concat(s)
+			s = tail
+			model = append(model[from:], model[:from]...)
+		default:
+			sum := 0
+			for _, value := range model[from:to] {
+				sum += value
+			}
+			assert.Equal(t, sum, s.Query(from, to))
+		}
+		if i%100 == 0 {
+			if err := s.Validate(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	assert.Equal(t, model, s.Values())
+}
+
+func TestSequence_ReverseWithOrderedMonoid(t *testing.T) {
+	s := treap.NewSequenceFrom([]string{"a", "b", "c", "d", "e", "f"}, concat(), noUpdate())
+
+	s.Reverse(1, 5)
+	assert.Equal(t, "aedcbf", s.Query(0, 6))
+	assert.Equal(t, "edc", s.Query(1, 4))
+	s.Reverse(0, 3)
+	assert.Equal(t, "deacbf", s.Query(0, 6))
+	s.Reverse(0, 6)
+	assert.Equal(t, "fbcaed", s.Query(0, 6))
+	assert.Equal(t, []string{"f", "b", "c", "a", "e", "d"}, s.Values())
+}